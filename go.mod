@@ -3,12 +3,12 @@ module github.com/lopezator/filterer
 go 1.20
 
 require (
-	buf.build/gen/go/lopezator/filterer/connectrpc/go v1.16.0-20240327160219-7738b154eb0a.1
-	buf.build/gen/go/lopezator/filterer/protocolbuffers/go v1.34.1-20240521141429-fc22909e51e2.1
 	connectrpc.com/connect v1.16.0
 	github.com/google/cel-go v0.11.2
 	golang.org/x/net v0.23.0
 	google.golang.org/genproto/googleapis/api v0.0.0-20240401170217-c3f982113cda
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240325203815-454cdb8f5daa
+	google.golang.org/protobuf v1.34.1
 	gopkg.in/yaml.v3 v3.0.1
 )
 
@@ -19,7 +19,5 @@ require (
 	github.com/rogpeppe/go-internal v1.9.0 // indirect
 	github.com/stoewer/go-strcase v1.2.0 // indirect
 	golang.org/x/text v0.14.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20240325203815-454cdb8f5daa // indirect
-	google.golang.org/protobuf v1.34.1 // indirect
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
 )
@@ -0,0 +1,402 @@
+package expr
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// AIP-160 keywords.
+const (
+	aipAnd = "AND"
+	aipOr  = "OR"
+	aipNot = "NOT"
+)
+
+// aipTokenKind is the kind of an AIP-160 token.
+type aipTokenKind int
+
+const (
+	aipEOF aipTokenKind = iota
+	aipText
+	aipString
+	aipComparator
+	aipMinus
+	aipLParen
+	aipRParen
+)
+
+// aipToken is a token of an AIP-160 filter, located by its byte offsets.
+type aipToken struct {
+	kind aipTokenKind
+	// text is the text of the token, unquoted for strings.
+	text   string
+	offset int
+	end    int
+}
+
+// aipComparators maps the AIP-160 comparators to their operator, : being the
+// has operator, mapped according to the field compared.
+var aipComparators = map[string]string{
+	"=":  OperatorEquals,
+	"!=": OperatorNotEquals,
+	"<":  OperatorLess,
+	"<=": OperatorLessEquals,
+	">":  OperatorGreater,
+	">=": OperatorGreaterEquals,
+	":":  OperatorContains,
+}
+
+// ParseAIP produces a database friendly expr from a Google AIP-160 filter,
+// such as display_name = "paco" AND age > 3 OR labels:prod, checked against
+// the same fields as Parse, which it returns the same expression as for the
+// equivalent CEL. As in AIP-160, OR binds tighter than AND, and sequences of
+// restrictions are ANDed. Strings compared by = and != match as prefix,
+// suffix or substring with a leading and, or, trailing * wildcard, and : tests
+// the presence of the field given *, and contains the value for strings and
+// string arrays. Errors are returned as *Error, located within the filter.
+func (p *Parser) ParseAIP(filter string) (*Expr, error) {
+	if strings.TrimSpace(filter) == "" {
+		return &Expr{}, nil
+	}
+	tokens, err := lexAIP(filter)
+	if err != nil {
+		return nil, err
+	}
+	ap := &aipParser{parser: p, filter: filter, tokens: tokens}
+	root, err := ap.expression()
+	if err != nil {
+		return nil, err
+	}
+	if tok := ap.peek(); tok.kind != aipEOF {
+		return nil, ap.errorAt(tok, SyntaxErrorKind, "unexpected %s", tok.text)
+	}
+	if depthOf(root) > maxDepth {
+		return nil, ap.errorAt(tokens[0], DepthLimitErrorKind, "limit of %d depth level exceed", maxDepth)
+	}
+	return &Expr{Root: root}, nil
+}
+
+// lexAIP splits the filter into tokens, ended by an EOF one.
+func lexAIP(filter string) ([]aipToken, *Error) {
+	var tokens []aipToken
+	for i := 0; i < len(filter); {
+		r, size := utf8.DecodeRuneInString(filter[i:])
+		start := i
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+			continue
+		case r == '(' || r == ')':
+			kind := aipLParen
+			if r == ')' {
+				kind = aipRParen
+			}
+			tokens = append(tokens, aipToken{kind: kind, text: string(r), offset: start, end: i + 1})
+			i++
+		case r == '-':
+			tokens = append(tokens, aipToken{kind: aipMinus, text: "-", offset: start, end: i + 1})
+			i++
+		case r == '"' || r == '\'':
+			end := tokenEnd(filter, i)
+			text, ok := unquote(filter[start:end])
+			if !ok {
+				return nil, aipError(filter, aipToken{offset: start, end: end}, SyntaxErrorKind, "invalid string %s", filter[start:end])
+			}
+			tokens = append(tokens, aipToken{kind: aipString, text: text, offset: start, end: end})
+			i = end
+		case strings.ContainsRune("=<>!:", r):
+			for _, comparator := range []string{"<=", ">=", "!=", "=", "<", ">", ":"} {
+				if strings.HasPrefix(filter[i:], comparator) {
+					i += len(comparator)
+					break
+				}
+			}
+			if i == start {
+				return nil, aipError(filter, aipToken{offset: start, end: start + 1}, SyntaxErrorKind, "unexpected %c", r)
+			}
+			tokens = append(tokens, aipToken{kind: aipComparator, text: filter[start:i], offset: start, end: i})
+		case r == ',':
+			return nil, aipError(filter, aipToken{offset: start, end: start + 1}, SyntaxErrorKind, "unexpected %c", r)
+		default:
+			for i < len(filter) {
+				r, size := utf8.DecodeRuneInString(filter[i:])
+				if unicode.IsSpace(r) || strings.ContainsRune(`()"'=<>!:,`, r) {
+					break
+				}
+				i += size
+			}
+			tokens = append(tokens, aipToken{kind: aipText, text: filter[start:i], offset: start, end: i})
+		}
+	}
+	return append(tokens, aipToken{kind: aipEOF, text: "end of filter", offset: len(filter), end: len(filter)}), nil
+}
+
+// unquote returns the text of the quoted string, with its escape sequences
+// interpreted, and whether it's valid.
+func unquote(s string) (string, bool) {
+	quote := s[0]
+	if len(s) < 2 || s[len(s)-1] != quote {
+		return "", false
+	}
+	var b strings.Builder
+	for s = s[1 : len(s)-1]; s != ""; {
+		r, multibyte, tail, err := strconv.UnquoteChar(s, quote)
+		if err != nil {
+			return "", false
+		}
+		if multibyte {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte(byte(r))
+		}
+		s = tail
+	}
+	return b.String(), true
+}
+
+// aipError returns an error of the given kind located at the token.
+func aipError(filter string, tok aipToken, kind ErrorKind, format string, args ...any) *Error {
+	err := builderError(kind, format, args...)
+	err.setSpan(filter, tok.offset, tok.end)
+	return err
+}
+
+// aipParser is a recursive descent parser of AIP-160 filters.
+type aipParser struct {
+	parser *Parser
+	filter string
+	tokens []aipToken
+	pos    int
+}
+
+func (ap *aipParser) peek() aipToken {
+	return ap.tokens[ap.pos]
+}
+
+func (ap *aipParser) next() aipToken {
+	tok := ap.tokens[ap.pos]
+	if tok.kind != aipEOF {
+		ap.pos++
+	}
+	return tok
+}
+
+func (ap *aipParser) errorAt(tok aipToken, kind ErrorKind, format string, args ...any) *Error {
+	return aipError(ap.filter, tok, kind, format, args...)
+}
+
+// isKeyword reports whether the token is the given keyword.
+func isKeyword(tok aipToken, keyword string) bool {
+	return tok.kind == aipText && tok.text == keyword
+}
+
+// expression parses sequences joined by AND, which are chained along with
+// the factors of the sequences as CEL chains the terms of &&.
+func (ap *aipParser) expression() (Node, *Error) {
+	var terms []Node
+	for {
+		factors, err := ap.sequence()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, factors...)
+		if !isKeyword(ap.peek(), aipAnd) {
+			return balancedChain(OperatorAnd, terms), nil
+		}
+		ap.next()
+	}
+}
+
+// sequence parses factors juxtaposed, implicitly ANDed.
+func (ap *aipParser) sequence() ([]Node, *Error) {
+	var factors []Node
+	for {
+		factor, err := ap.factor()
+		if err != nil {
+			return nil, err
+		}
+		factors = append(factors, factor)
+		tok := ap.peek()
+		switch {
+		case tok.kind == aipText && tok.text != aipAnd && tok.text != aipOr, tok.kind == aipMinus, tok.kind == aipLParen:
+		default:
+			return factors, nil
+		}
+	}
+}
+
+// factor parses terms joined by OR.
+func (ap *aipParser) factor() (Node, *Error) {
+	var terms []Node
+	for {
+		term, err := ap.term()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+		if !isKeyword(ap.peek(), aipOr) {
+			return balancedChain(OperatorOr, terms), nil
+		}
+		ap.next()
+	}
+}
+
+// term parses a simple expression, negated by NOT or -.
+func (ap *aipParser) term() (Node, *Error) {
+	if tok := ap.peek(); isKeyword(tok, aipNot) || tok.kind == aipMinus {
+		ap.next()
+		node, err := ap.simple()
+		if err != nil {
+			return nil, err
+		}
+		return &NotExpr{Not: node}, nil
+	}
+	return ap.simple()
+}
+
+// simple parses a restriction or a parenthesized expression.
+func (ap *aipParser) simple() (Node, *Error) {
+	tok := ap.next()
+	switch {
+	case tok.kind == aipLParen:
+		node, err := ap.expression()
+		if err != nil {
+			return nil, err
+		}
+		if closing := ap.next(); closing.kind != aipRParen {
+			return nil, ap.errorAt(closing, SyntaxErrorKind, "missing )")
+		}
+		return node, nil
+	case tok.kind == aipText && tok.text != aipAnd && tok.text != aipOr && tok.text != aipNot:
+		return ap.restriction(tok)
+	default:
+		return nil, ap.errorAt(tok, SyntaxErrorKind, "unexpected %s", tok.text)
+	}
+}
+
+// restriction parses the comparison of the field, or the bare boolean field.
+func (ap *aipParser) restriction(fieldTok aipToken) (Node, *Error) {
+	if tok := ap.peek(); tok.kind == aipLParen && tok.offset == fieldTok.end {
+		err := ap.errorAt(fieldTok, UnsupportedErrorKind, "unsupported function %s", fieldTok.text)
+		err.Operator = fieldTok.text
+		return nil, err
+	}
+	field, err := ap.parser.buildField(fieldTok.text)
+	if err != nil {
+		err.setSpan(ap.filter, fieldTok.offset, fieldTok.end)
+		return nil, err
+	}
+	if ap.peek().kind != aipComparator {
+		return ap.build(Bool(field.Name), fieldTok)
+	}
+	comparatorTok := ap.next()
+	op := aipComparators[comparatorTok.text]
+
+	valueTok, quoted, err := ap.value()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	// the has operator tests presence, contains strings, or else equals
+	case comparatorTok.text == ":" && !quoted && valueTok.text == "*":
+		return ap.build(Present(field.Name), fieldTok)
+	case comparatorTok.text == ":" && (field.Ftype == StringFieldType || field.Ftype == StringArrayFieldType):
+		return ap.build(Contains(field.Name, valueTok.text), fieldTok)
+	case comparatorTok.text == ":":
+		op = OperatorEquals
+	case (op == OperatorEquals || op == OperatorNotEquals) && field.Ftype == StringFieldType:
+		if cond := wildcardCond(field.Name, valueTok.text); cond != nil {
+			if op == OperatorNotEquals {
+				cond = Not(cond)
+			}
+			return ap.build(cond, fieldTok)
+		}
+	}
+
+	value, err := aipValue(valueTok.text, quoted, field.Ftype)
+	if err != nil {
+		err.Field = field.Name
+		err.Operator = op
+		err.setSpan(ap.filter, valueTok.offset, valueTok.end)
+		return nil, err
+	}
+	return ap.build(&opCond{field: field.Name, op: op, values: []any{value}}, fieldTok)
+}
+
+// value parses the value compared, a string, text or negative number, and
+// whether it's quoted.
+func (ap *aipParser) value() (aipToken, bool, *Error) {
+	tok := ap.next()
+	switch tok.kind {
+	case aipString:
+		return tok, true, nil
+	case aipText:
+		return tok, false, nil
+	case aipMinus:
+		if number := ap.peek(); number.kind == aipText && number.offset == tok.end {
+			ap.next()
+			return aipToken{kind: aipText, text: "-" + number.text, offset: tok.offset, end: number.end}, false, nil
+		}
+	}
+	return aipToken{}, false, ap.errorAt(tok, SyntaxErrorKind, "missing value before %s", tok.text)
+}
+
+// build builds the condition, locating its errors at the field.
+func (ap *aipParser) build(cond Cond, fieldTok aipToken) (Node, *Error) {
+	node, err := cond.build(ap.parser)
+	if err != nil {
+		err.setSpan(ap.filter, fieldTok.offset, fieldTok.end)
+		return nil, err
+	}
+	return node, nil
+}
+
+// wildcardCond returns the condition of the string matching the value with a
+// leading or trailing * wildcard, nil if it has none.
+func wildcardCond(field, value string) Cond {
+	prefix, suffix := strings.HasPrefix(value, "*"), strings.HasSuffix(value, "*")
+	trimmed := strings.TrimSuffix(strings.TrimPrefix(value, "*"), "*")
+	switch {
+	case trimmed == "":
+		return nil
+	case prefix && suffix:
+		return Contains(field, trimmed)
+	case prefix:
+		return EndsWith(field, trimmed)
+	case suffix:
+		return StartsWith(field, trimmed)
+	default:
+		return nil
+	}
+}
+
+// aipValue converts the text of the value to the type of the args of fields
+// of the given type. Bare null is the null value.
+func aipValue(text string, quoted bool, ftype FieldType) (any, *Error) {
+	if !quoted && text == "null" {
+		return nil, nil
+	}
+	var value any
+	var err error
+	switch ftype {
+	case BoolFieldType:
+		value, err = strconv.ParseBool(text)
+	case IntegerFieldType:
+		value, err = strconv.ParseInt(text, 10, 64)
+	case DoubleFieldType:
+		value, err = strconv.ParseFloat(text, 64)
+	case TimestampFieldType:
+		var t time.Time
+		t, err = time.Parse(time.RFC3339, text)
+		value = t.UTC()
+	default:
+		value = text
+	}
+	if err != nil {
+		return nil, builderError(InvalidValueErrorKind, "invalid %s value %s", ftype, text)
+	}
+	return value, nil
+}
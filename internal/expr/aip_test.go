@@ -0,0 +1,110 @@
+package expr
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseAIP(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "empty filter", input: " ", want: ""},
+		{name: "equals", input: `first_name = "paco"`, want: `first_name == "paco"`},
+		{name: "single quoted string", input: `first_name = 'O\'Brien'`, want: `first_name == "O'Brien"`},
+		{name: "bare string", input: "first_name = paco", want: `first_name == "paco"`},
+		{name: "comparators", input: "age != 1 AND age < 2 AND age <= 3 AND age > 4 AND age >= 5", want: "age != 1 && age < 2 && age <= 3 && age > 4 && age >= 5"},
+		{name: "negative number", input: "company.location.zone = -1", want: "company.location.zone == -1"},
+		{name: "coerced integer", input: "price > 10", want: "price > 10.0"},
+		{name: "bool", input: "active = true", want: "active == true"},
+		{name: "bare bool field", input: "active", want: "active"},
+		{name: "timestamp", input: `birth_date > "2024-01-01T01:00:00+01:00"`, want: "birth_date > timestamp('2024-01-01T00:00:00Z')"},
+		{name: "null", input: "birth_date = null", want: "birth_date == null"},
+		{name: "quoted null", input: `first_name = "null"`, want: `first_name == "null"`},
+		{name: "prefix", input: `first_name = "pa*"`, want: `first_name.startsWith("pa")`},
+		{name: "suffix", input: `first_name = "*co"`, want: `first_name.endsWith("co")`},
+		{name: "substring", input: `first_name = "*ac*"`, want: `first_name.contains("ac")`},
+		{name: "negated wildcard", input: `first_name != "pa*"`, want: `!first_name.startsWith("pa")`},
+		{name: "has element", input: "tags:prod", want: `tags.contains("prod")`},
+		{name: "has substring", input: "first_name:ac", want: `first_name.contains("ac")`},
+		{name: "has value", input: "age:3", want: "age == 3"},
+		{name: "has any", input: "birth_date:*", want: "present(birth_date)"},
+		{name: "precedence", input: `first_name = "paco" AND age > 3 OR tags:prod`, want: `first_name == "paco" && (age > 3 || tags.contains("prod"))`},
+		{name: "sequence", input: "active age > 3 tags:prod", want: `active && age > 3 && tags.contains("prod")`},
+		{name: "sequence and conjunction", input: "active age > 3 AND tags:prod", want: `active && age > 3 && tags.contains("prod")`},
+		{name: "or chain", input: "age = 1 OR age = 2 OR age = 3", want: "age == 1 || age == 2 || age == 3"},
+		{name: "not", input: "NOT active", want: "!active"},
+		{name: "minus", input: "-age > 3", want: "!(age > 3)"},
+		{name: "composite", input: "(active OR age > 3) AND NOT (tags:prod OR tags:dev)", want: `(active || age > 3) && !(tags.contains("prod") || tags.contains("dev"))`},
+		{name: "double negation", input: "NOT (NOT active)", want: "!(!active)"},
+		{name: "nested field", input: "company.fortune500 AND company.name:acme", want: `company.fortune500 && company.name.contains("acme")`},
+	}
+
+	parser := newSQLTestParser(t)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parser.ParseAIP(tt.input)
+			if err != nil {
+				t.Fatalf("ParseAIP() error: %v", err)
+			}
+			want, err := parser.Parse(tt.want)
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ParseAIP() got: %s, want %s", formatted(got), formatted(want))
+			}
+		})
+	}
+}
+
+func TestParseAIPErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		wantKind   ErrorKind
+		wantOffset int
+		wantEnd    int
+		wantField  string
+	}{
+		{name: "unknown field", input: "active AND frist_name = 'a'", wantKind: UnknownFieldErrorKind, wantOffset: 11, wantEnd: 21, wantField: "frist_name"},
+		{name: "not a boolean", input: "age", wantKind: TypeMismatchErrorKind, wantOffset: 0, wantEnd: 3, wantField: "age"},
+		{name: "invalid integer", input: "age > abc", wantKind: InvalidValueErrorKind, wantOffset: 6, wantEnd: 9, wantField: "age"},
+		{name: "invalid timestamp", input: `birth_date > "yesterday"`, wantKind: InvalidValueErrorKind, wantOffset: 13, wantEnd: 24, wantField: "birth_date"},
+		{name: "unsupported operator", input: "active > true", wantKind: TypeMismatchErrorKind, wantOffset: 0, wantEnd: 6, wantField: "active"},
+		{name: "unsupported null", input: "birth_date > null", wantKind: TypeMismatchErrorKind, wantOffset: 0, wantEnd: 10, wantField: "birth_date"},
+		{name: "missing value", input: "age >", wantKind: SyntaxErrorKind, wantOffset: 5, wantEnd: 5},
+		{name: "missing parenthesis", input: "(active", wantKind: SyntaxErrorKind, wantOffset: 7, wantEnd: 7},
+		{name: "unexpected parenthesis", input: "active)", wantKind: SyntaxErrorKind, wantOffset: 6, wantEnd: 7},
+		{name: "dangling and", input: "active AND", wantKind: SyntaxErrorKind, wantOffset: 10, wantEnd: 10},
+		{name: "unterminated string", input: `first_name = "a`, wantKind: SyntaxErrorKind, wantOffset: 13, wantEnd: 15},
+		{name: "function", input: "size(tags) > 1", wantKind: UnsupportedErrorKind, wantOffset: 0, wantEnd: 4},
+		{name: "depth limit", input: "NOT (NOT (NOT (NOT (NOT active))))", wantKind: DepthLimitErrorKind, wantOffset: 0, wantEnd: 3},
+	}
+
+	parser := newSQLTestParser(t)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := parser.ParseAIP(tt.input)
+			var exprErr *Error
+			if !errors.As(err, &exprErr) {
+				t.Fatalf("ParseAIP() error: %v, want *Error", err)
+			}
+			if exprErr.Kind != tt.wantKind || exprErr.Offset != tt.wantOffset || exprErr.End != tt.wantEnd || exprErr.Field != tt.wantField {
+				t.Errorf("ParseAIP() error: %v (kind %s, offset %d, end %d, field %q), want kind %s, offset %d, end %d, field %q",
+					exprErr, exprErr.Kind, exprErr.Offset, exprErr.End, exprErr.Field, tt.wantKind, tt.wantOffset, tt.wantEnd, tt.wantField)
+			}
+		})
+	}
+}
@@ -0,0 +1,434 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+// Cond is a condition of an expression built programmatically, such as
+// And(Eq("tenant_id", id), Present("deleted_at")). Conditions are checked
+// against the fields of a parser by Parser.Build.
+type Cond interface {
+	build(p *Parser) (Node, *Error)
+}
+
+// Eq returns the condition of the field being equal to the value.
+func Eq(field string, value any) Cond {
+	return &opCond{field: field, op: OperatorEquals, values: []any{value}}
+}
+
+// Ne returns the condition of the field not being equal to the value.
+func Ne(field string, value any) Cond {
+	return &opCond{field: field, op: OperatorNotEquals, values: []any{value}}
+}
+
+// Lt returns the condition of the field being less than the value.
+func Lt(field string, value any) Cond {
+	return &opCond{field: field, op: OperatorLess, values: []any{value}}
+}
+
+// Le returns the condition of the field being less than or equal to the
+// value.
+func Le(field string, value any) Cond {
+	return &opCond{field: field, op: OperatorLessEquals, values: []any{value}}
+}
+
+// Gt returns the condition of the field being greater than the value.
+func Gt(field string, value any) Cond {
+	return &opCond{field: field, op: OperatorGreater, values: []any{value}}
+}
+
+// Ge returns the condition of the field being greater than or equal to the
+// value.
+func Ge(field string, value any) Cond {
+	return &opCond{field: field, op: OperatorGreaterEquals, values: []any{value}}
+}
+
+// In returns the condition of the field being equal to any of the values, of
+// which there must be at least one.
+func In(field string, values ...any) Cond {
+	return &opCond{field: field, op: OperatorIn, values: values}
+}
+
+// ExactEq returns the condition of the field being equal to the value, unlike
+// Eq comparing strings case sensitively, such as to scope a tenant.
+func ExactEq(field string, value any) Cond {
+	return &opCond{field: field, op: OperatorEquals, values: []any{value}, caseSensitive: true}
+}
+
+// ExactIn returns the condition of the field being equal to any of the
+// values, unlike In comparing strings case sensitively.
+func ExactIn(field string, values ...any) Cond {
+	return &opCond{field: field, op: OperatorIn, values: values, caseSensitive: true}
+}
+
+// StartsWith returns the condition of the field starting with the prefix.
+func StartsWith(field, prefix string) Cond {
+	return &opCond{field: field, op: OperatorStartsWith, values: []any{prefix}}
+}
+
+// EndsWith returns the condition of the field ending with the suffix.
+func EndsWith(field, suffix string) Cond {
+	return &opCond{field: field, op: OperatorEndsWith, values: []any{suffix}}
+}
+
+// Contains returns the condition of the field containing the value, a
+// substring of string fields or an element of string array fields.
+func Contains(field, value string) Cond {
+	return &opCond{field: field, op: OperatorContains, values: []any{value}}
+}
+
+// Present returns the condition of the field being set.
+func Present(field string) Cond {
+	return &presentCond{field: field}
+}
+
+// Bool returns the condition of the boolean field being true.
+func Bool(field string) Cond {
+	return &boolCond{field: field}
+}
+
+// Not returns the negation of the condition.
+func Not(cond Cond) Cond {
+	return &notCond{cond: cond}
+}
+
+// And returns the condition of all the conditions being met.
+func And(conds ...Cond) Cond {
+	return &chainCond{op: OperatorAnd, conds: conds}
+}
+
+// Or returns the condition of any of the conditions being met.
+func Or(conds ...Cond) Cond {
+	return &chainCond{op: OperatorOr, conds: conds}
+}
+
+// Compare returns the condition of the field compared by the operator to the
+// values, such as Compare("age", ">", 18), for operators known at runtime
+// only. Operators other than in take a single value.
+func Compare(field, op string, values ...any) Cond {
+	return &opCond{field: field, op: op, values: values}
+}
+
+// SizeOperand is the size of a field, to be compared to integers.
+type SizeOperand struct {
+	field string
+}
+
+// Size returns the size of the field, such as the number of elements of a
+// string array.
+func Size(field string) *SizeOperand {
+	return &SizeOperand{field: field}
+}
+
+// Eq returns the condition of the size being equal to the value.
+func (s *SizeOperand) Eq(value any) Cond {
+	return &opCond{field: s.field, size: true, op: OperatorEquals, values: []any{value}}
+}
+
+// Ne returns the condition of the size not being equal to the value.
+func (s *SizeOperand) Ne(value any) Cond {
+	return &opCond{field: s.field, size: true, op: OperatorNotEquals, values: []any{value}}
+}
+
+// Lt returns the condition of the size being less than the value.
+func (s *SizeOperand) Lt(value any) Cond {
+	return &opCond{field: s.field, size: true, op: OperatorLess, values: []any{value}}
+}
+
+// Le returns the condition of the size being less than or equal to the
+// value.
+func (s *SizeOperand) Le(value any) Cond {
+	return &opCond{field: s.field, size: true, op: OperatorLessEquals, values: []any{value}}
+}
+
+// Gt returns the condition of the size being greater than the value.
+func (s *SizeOperand) Gt(value any) Cond {
+	return &opCond{field: s.field, size: true, op: OperatorGreater, values: []any{value}}
+}
+
+// Ge returns the condition of the size being greater than or equal to the
+// value.
+func (s *SizeOperand) Ge(value any) Cond {
+	return &opCond{field: s.field, size: true, op: OperatorGreaterEquals, values: []any{value}}
+}
+
+// In returns the condition of the size being equal to any of the values, of
+// which there must be at least one.
+func (s *SizeOperand) In(values ...any) Cond {
+	return &opCond{field: s.field, size: true, op: OperatorIn, values: values}
+}
+
+// Compare returns the condition of the size compared by the operator to the
+// values.
+func (s *SizeOperand) Compare(op string, values ...any) Cond {
+	return &opCond{field: s.field, size: true, op: op, values: values}
+}
+
+// Build returns the expression of the condition, checking its fields are
+// known by the parser, its operators and values fit their types, and it's no
+// deeper than parsed expressions can be. Values are converted as if parsed,
+// so Build(Gt("price", 10)) returns the same expression as
+// Parse("price > 10"), and errors are *Error values, without location.
+func (p *Parser) Build(cond Cond) (*Expr, error) {
+	if cond == nil {
+		return nil, builderError(UnsupportedErrorKind, "missing condition")
+	}
+	root, err := cond.build(p)
+	if err != nil {
+		return nil, err
+	}
+	if depthOf(root) > maxDepth {
+		return nil, builderError(DepthLimitErrorKind, "limit of %d depth level exceed", maxDepth)
+	}
+	return &Expr{Root: root}, nil
+}
+
+// depthOf returns the depth of the node, counted as the parser does.
+func depthOf(node Node) int {
+	switch n := node.(type) {
+	case *NotExpr:
+		return 1 + depthOf(n.Not)
+	case *AndExpr:
+		return 1 + maxInt(depthOf(n.Left), depthOf(n.Right))
+	case *OrExpr:
+		return 1 + maxInt(depthOf(n.Left), depthOf(n.Right))
+	default:
+		return 1
+	}
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// builderError returns an error of the given kind, without location.
+func builderError(kind ErrorKind, format string, args ...any) *Error {
+	return &Error{
+		Kind:   kind,
+		Msg:    fmt.Sprintf(format, args...),
+		Offset: -1,
+		End:    -1,
+	}
+}
+
+// buildField returns the field of the given name known by the parser.
+func (p *Parser) buildField(name string) (*Field, *Error) {
+	field, ok := p.fields[name]
+	if !ok {
+		err := builderError(UnknownFieldErrorKind, "unknown field %s", name)
+		err.Field = name
+		err.Suggestions = p.suggestFields(name)
+		return nil, err
+	}
+	return field, nil
+}
+
+// comparisonOperators are the operators of operation conditions.
+var comparisonOperators = []string{
+	OperatorEquals,
+	OperatorNotEquals,
+	OperatorLess,
+	OperatorLessEquals,
+	OperatorGreater,
+	OperatorGreaterEquals,
+	OperatorIn,
+	OperatorStartsWith,
+	OperatorEndsWith,
+	OperatorContains,
+}
+
+type opCond struct {
+	field         string
+	size          bool
+	op            string
+	values        []any
+	caseSensitive bool
+}
+
+func (c *opCond) build(p *Parser) (Node, *Error) {
+	field, err := p.buildField(c.field)
+	if err != nil {
+		return nil, err
+	}
+	var left Node = field
+	ftype := field.Ftype
+	if c.size {
+		if !containsString(p.Operators(field.Ftype), OperatorSize) {
+			err := builderError(TypeMismatchErrorKind, "operator %s can't be applied to field %s of type %s", OperatorSize, field.Name, field.Ftype)
+			err.Field = field.Name
+			err.Operator = OperatorSize
+			return nil, err
+		}
+		left = &SizeExpr{Field: field}
+		ftype = IntegerFieldType
+	}
+
+	// errors refer to the field and operator at fault
+	withField := func(err *Error) *Error {
+		err.Field = field.Name
+		err.Operator = c.op
+		return err
+	}
+
+	if !containsString(comparisonOperators, c.op) {
+		return nil, withField(builderError(UnsupportedErrorKind, "unsupported operator %s", c.op))
+	}
+	// in requires values, as IN () isn't valid SQL, and the rest a single one
+	switch {
+	case c.op == OperatorIn && len(c.values) == 0:
+		return nil, withField(builderError(UnsupportedErrorKind, "%s requires at least one value", c.op))
+	case c.op != OperatorIn && len(c.values) != 1:
+		return nil, withField(builderError(UnsupportedErrorKind, "%s requires a single value", c.op))
+	}
+
+	// null is only accepted by equality and inequality, of any field
+	opExpr := &OpExpr{Left: left, Op: c.op, CaseSensitive: c.caseSensitive}
+	if len(c.values) == 1 && c.values[0] == nil {
+		opExpr.Args = []any{nil}
+		if !isNullComparison(opExpr) {
+			return nil, withField(builderError(TypeMismatchErrorKind, "null is only supported by == and != operators"))
+		}
+		return opExpr, nil
+	}
+	if !containsString(p.Operators(ftype), c.op) {
+		return nil, withField(builderError(TypeMismatchErrorKind, "operator %s can't be applied to field %s of type %s", c.op, field.Name, ftype))
+	}
+	for _, value := range c.values {
+		arg, err := convertValue(value, ftype)
+		if err != nil {
+			return nil, withField(err)
+		}
+		opExpr.Args = append(opExpr.Args, arg)
+	}
+	return opExpr, nil
+}
+
+// convertValue converts the value to the type of the args of fields of the
+// given type, as produced by the parser.
+func convertValue(value any, ftype FieldType) (any, *Error) {
+	if t, ok := value.(time.Time); ok {
+		if ftype != TimestampFieldType {
+			return nil, valueTypeError(value, ftype)
+		}
+		return t.UTC(), nil
+	}
+	v := reflect.ValueOf(value)
+	if !v.IsValid() {
+		return nil, builderError(TypeMismatchErrorKind, "null is only supported by == and != operators")
+	}
+	switch {
+	case v.Kind() == reflect.Bool && ftype == BoolFieldType:
+		return v.Bool(), nil
+	case v.Kind() == reflect.String && (ftype == StringFieldType || ftype == StringArrayFieldType):
+		return v.String(), nil
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 && ftype == BytesFieldType:
+		return v.Bytes(), nil
+	case v.CanInt() && ftype == IntegerFieldType:
+		return v.Int(), nil
+	case v.CanUint() && ftype == IntegerFieldType:
+		if v.Uint() > math.MaxInt64 {
+			return nil, builderError(InvalidValueErrorKind, "value %d overflows int64", v.Uint())
+		}
+		return int64(v.Uint()), nil
+	case v.CanInt() && ftype == DoubleFieldType:
+		return float64(v.Int()), nil
+	case v.CanUint() && ftype == DoubleFieldType:
+		return float64(v.Uint()), nil
+	case v.CanFloat() && ftype == DoubleFieldType:
+		// CEL has no literals of non-finite doubles, which couldn't be parsed
+		// back once formatted
+		if math.IsNaN(v.Float()) || math.IsInf(v.Float(), 0) {
+			return nil, builderError(InvalidValueErrorKind, "non-finite value %v", v.Float())
+		}
+		return v.Float(), nil
+	default:
+		return nil, valueTypeError(value, ftype)
+	}
+}
+
+func valueTypeError(value any, ftype FieldType) *Error {
+	return builderError(TypeMismatchErrorKind, "value %v of type %T doesn't fit a field of type %s", value, value, ftype)
+}
+
+type presentCond struct {
+	field string
+}
+
+func (c *presentCond) build(p *Parser) (Node, *Error) {
+	field, err := p.buildField(c.field)
+	if err != nil {
+		return nil, err
+	}
+	if !containsString(p.Operators(field.Ftype), OperatorPresent) {
+		err := builderError(TypeMismatchErrorKind, "operator %s can't be applied to field %s of type %s", OperatorPresent, field.Name, field.Ftype)
+		err.Field = field.Name
+		err.Operator = OperatorPresent
+		return nil, err
+	}
+	return &PresentExpr{Field: field}, nil
+}
+
+type boolCond struct {
+	field string
+}
+
+func (c *boolCond) build(p *Parser) (Node, *Error) {
+	field, err := p.buildField(c.field)
+	if err != nil {
+		return nil, err
+	}
+	if field.Ftype != BoolFieldType {
+		err := builderError(TypeMismatchErrorKind, "field %s is not a boolean", field.Name)
+		err.Field = field.Name
+		return nil, err
+	}
+	return field, nil
+}
+
+type notCond struct {
+	cond Cond
+}
+
+func (c *notCond) build(p *Parser) (Node, *Error) {
+	if c.cond == nil {
+		return nil, builderError(UnsupportedErrorKind, "missing condition of %s", OperatorNot)
+	}
+	node, err := c.cond.build(p)
+	if err != nil {
+		return nil, err
+	}
+	return &NotExpr{Not: node}, nil
+}
+
+type chainCond struct {
+	op    string
+	conds []Cond
+}
+
+// build returns the conditions joined as CEL parses a chain of them, into a
+// balanced tree.
+func (c *chainCond) build(p *Parser) (Node, *Error) {
+	if len(c.conds) == 0 {
+		err := builderError(UnsupportedErrorKind, "%s requires at least one condition", c.op)
+		err.Operator = c.op
+		return nil, err
+	}
+	terms := make([]Node, len(c.conds))
+	for i, cond := range c.conds {
+		if cond == nil {
+			return nil, builderError(UnsupportedErrorKind, "missing condition of %s", c.op)
+		}
+		var err *Error
+		terms[i], err = cond.build(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return balancedChain(c.op, terms), nil
+}
@@ -0,0 +1,233 @@
+package expr
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBuild(t *testing.T) {
+	t.Parallel()
+
+	type score int32
+
+	tests := []struct {
+		name string
+		cond Cond
+		want string
+	}{
+		{name: "equality", cond: Eq("first_name", "A"), want: "first_name == 'A'"},
+		{name: "inequality", cond: Ne("company.location.zone", uint8(2)), want: "company.location.zone != 2"},
+		{name: "comparisons", cond: And(Lt("age", 1), Le("age", 2), Gt("age", 3), Ge("age", 4)), want: "age < 1 && age <= 2 && age > 3 && age >= 4"},
+		{name: "named type", cond: Eq("age", score(7)), want: "age == 7"},
+		{name: "coerced double", cond: Gt("price", 10), want: "price > 10.0"},
+		{name: "double", cond: Gt("price", float32(0.5)), want: "price > 0.5"},
+		{name: "in list", cond: In("age", 1, int64(2), uint(3)), want: "age in [1, 2, 3]"},
+		{name: "methods", cond: Or(StartsWith("first_name", "A"), EndsWith("first_name", "b"), Contains("first_name", "c")), want: "first_name.startsWith('A') || first_name.endsWith('b') || first_name.contains('c')"},
+		{name: "array contains", cond: Contains("tags", "a"), want: "tags.contains('a')"},
+		{name: "null", cond: Eq("birth_date", nil), want: "birth_date == null"},
+		{name: "timestamp", cond: Lt("birth_date", time.Date(2024, 1, 1, 1, 0, 0, 0, time.FixedZone("", 3600))), want: "birth_date < timestamp('2024-01-01T00:00:00Z')"},
+		{name: "present", cond: Present("birth_date"), want: "present(birth_date)"},
+		{name: "size", cond: Size("tags").Gt(1), want: "size(tags) > 1"},
+		{name: "size in list", cond: Size("tags").In(1, 2), want: "size(tags) in [1, 2]"},
+		{name: "compare", cond: And(Compare("age", ">=", 18), Size("tags").Compare("==", 2)), want: "age >= 18 && size(tags) == 2"},
+		{name: "bool field", cond: Not(Bool("active")), want: "!active"},
+		{name: "single condition chain", cond: And(Bool("active")), want: "active"},
+		{name: "balanced chain", cond: And(Bool("active"), Eq("age", 1), Eq("age", 2), Eq("age", 3), Eq("age", 4)), want: "active && age == 1 && age == 2 && age == 3 && age == 4"},
+		{name: "nested chains", cond: Or(And(Bool("active"), Eq("age", 1)), Not(Or(Eq("age", 2), Eq("age", 3)))), want: "active && age == 1 || !(age == 2 || age == 3)"},
+	}
+
+	parser := newSQLTestParser(t)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			want, err := parser.Parse(tt.want)
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			got, err := parser.Build(tt.cond)
+			if err != nil {
+				t.Fatalf("Build() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Build() got: %s, want %s", formatted(got), formatted(want))
+			}
+		})
+	}
+}
+
+func TestBuildError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cond Cond
+		want *Error
+	}{
+		{
+			name: "missing condition",
+			cond: nil,
+			want: &Error{Kind: UnsupportedErrorKind},
+		},
+		{
+			name: "unknown field",
+			cond: Eq("frist_name", "A"),
+			want: &Error{Kind: UnknownFieldErrorKind, Field: "frist_name", Suggestions: []string{"first_name"}},
+		},
+		{
+			name: "unknown field within chain",
+			cond: And(Bool("active"), Not(Present("deleted_at"))),
+			want: &Error{Kind: UnknownFieldErrorKind, Field: "deleted_at"},
+		},
+		{
+			name: "value of another type",
+			cond: Eq("age", "1"),
+			want: &Error{Kind: TypeMismatchErrorKind, Field: "age", Operator: "=="},
+		},
+		{
+			name: "double value of an integer field",
+			cond: Eq("age", 1.5),
+			want: &Error{Kind: TypeMismatchErrorKind, Field: "age", Operator: "=="},
+		},
+		{
+			name: "overflowing value",
+			cond: In("age", uint64(1<<63)),
+			want: &Error{Kind: InvalidValueErrorKind, Field: "age", Operator: "in"},
+		},
+		{
+			name: "unsupported operator",
+			cond: StartsWith("age", "1"),
+			want: &Error{Kind: TypeMismatchErrorKind, Field: "age", Operator: "startsWith"},
+		},
+		{
+			name: "empty list",
+			cond: In("age"),
+			want: &Error{Kind: UnsupportedErrorKind, Field: "age", Operator: "in"},
+		},
+		{
+			name: "empty size list",
+			cond: Size("tags").In(),
+			want: &Error{Kind: UnsupportedErrorKind, Field: "tags", Operator: "in"},
+		},
+		{
+			name: "not a number",
+			cond: Gt("price", math.NaN()),
+			want: &Error{Kind: InvalidValueErrorKind, Field: "price", Operator: ">"},
+		},
+		{
+			name: "infinite value",
+			cond: In("price", 1.5, math.Inf(-1)),
+			want: &Error{Kind: InvalidValueErrorKind, Field: "price", Operator: "in"},
+		},
+		{
+			name: "unknown operator",
+			cond: Compare("age", "~=", 1),
+			want: &Error{Kind: UnsupportedErrorKind, Field: "age", Operator: "~="},
+		},
+		{
+			name: "missing value",
+			cond: Compare("first_name", "startsWith"),
+			want: &Error{Kind: UnsupportedErrorKind, Field: "first_name", Operator: "startsWith"},
+		},
+		{
+			name: "several values",
+			cond: Compare("age", "==", 1, 2),
+			want: &Error{Kind: UnsupportedErrorKind, Field: "age", Operator: "=="},
+		},
+		{
+			name: "null ordering",
+			cond: Lt("age", nil),
+			want: &Error{Kind: TypeMismatchErrorKind, Field: "age", Operator: "<"},
+		},
+		{
+			name: "null within list",
+			cond: In("age", 1, nil),
+			want: &Error{Kind: TypeMismatchErrorKind, Field: "age", Operator: "in"},
+		},
+		{
+			name: "size of a string",
+			cond: Size("first_name").Eq(1),
+			want: &Error{Kind: TypeMismatchErrorKind, Field: "first_name", Operator: "size"},
+		},
+		{
+			name: "non boolean field",
+			cond: Bool("age"),
+			want: &Error{Kind: TypeMismatchErrorKind, Field: "age"},
+		},
+		{
+			name: "depth limit",
+			cond: Not(Not(Not(Not(Not(Bool("active")))))),
+			want: &Error{Kind: DepthLimitErrorKind},
+		},
+		{
+			name: "empty chain",
+			cond: Or(),
+			want: &Error{Kind: UnsupportedErrorKind, Operator: "||"},
+		},
+	}
+
+	parser := newSQLTestParser(t)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := parser.Build(tt.cond)
+			got, ok := err.(*Error)
+			if !ok {
+				t.Fatalf("Build() error: %v, want *Error", err)
+			}
+			if got.Msg == "" {
+				t.Errorf("Build() error without message")
+			}
+			got.Msg = ""
+			tt.want.Offset, tt.want.End = -1, -1
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Build() error got: %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildCaseSensitive(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		cond       Cond
+		wantClause string
+		wantMatch  bool
+	}{
+		{name: "equality", cond: ExactEq("first_name", "paco"), wantClause: `"first_name" = ($1)`},
+		{name: "equality of the same case", cond: ExactEq("first_name", "Paco"), wantClause: `"first_name" = ($1)`, wantMatch: true},
+		{name: "in list", cond: ExactIn("first_name", "PACO", "Paco"), wantClause: `"first_name" IN ($1,$2)`, wantMatch: true},
+		{name: "non string field", cond: ExactEq("age", 35), wantClause: `"age" = ($1)`, wantMatch: true},
+	}
+
+	parser := newSQLTestParser(t)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			e, err := parser.Build(tt.cond)
+			if err != nil {
+				t.Fatalf("Build() error: %v", err)
+			}
+			gotClause, _, err := SQL(e)
+			if err != nil {
+				t.Fatalf("SQL() error: %v", err)
+			}
+			if gotClause != tt.wantClause {
+				t.Errorf("SQL() got clause: %v, want %v", gotClause, tt.wantClause)
+			}
+			gotMatch, err := Match(e, map[string]any{"first_name": "Paco", "age": 35})
+			if err != nil {
+				t.Fatalf("Match() error: %v", err)
+			}
+			if gotMatch != tt.wantMatch {
+				t.Errorf("Match() got: %v, want %v", gotMatch, tt.wantMatch)
+			}
+		})
+	}
+}
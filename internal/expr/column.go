@@ -0,0 +1,100 @@
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Column represents the SQL column a field is mapped to.
+type Column struct {
+	// Name holds the parts of the, optionally qualified, column identifier.
+	Name []string `json:"name"`
+	// Path holds the keys of the JSON path of the value within the column,
+	// if any.
+	Path []string `json:"path,omitempty"`
+}
+
+// ParseColumn parses a column mapping. The mapping is composed by a dot
+// separated, optionally qualified, column identifier and an optional JSON
+// path whose keys are separated by "->". Identifiers and keys can be double
+// quoted, doubling any double quote inside them. Examples:
+//
+//	display_name
+//	u.display_name
+//	"Display Name"
+//	u.profile->address->city
+func ParseColumn(s string) (*Column, error) {
+	column := &Column{}
+	inPath := false
+	for i := 0; ; {
+		// read an identifier or key
+		var part string
+		if i < len(s) && s[i] == '"' {
+			var sb strings.Builder
+			for i++; ; i++ {
+				if i >= len(s) {
+					return nil, fmt.Errorf("expr: unterminated quoted identifier in column %q", s)
+				}
+				if s[i] == '"' {
+					if i+1 < len(s) && s[i+1] == '"' {
+						i++
+					} else {
+						i++
+						break
+					}
+				}
+				sb.WriteByte(s[i])
+			}
+			part = sb.String()
+		} else {
+			start := i
+			for i < len(s) && isIdentChar(s[i]) {
+				i++
+			}
+			part = s[start:i]
+		}
+		if part == "" {
+			return nil, fmt.Errorf("expr: invalid column %q", s)
+		}
+		if inPath {
+			column.Path = append(column.Path, part)
+		} else {
+			column.Name = append(column.Name, part)
+		}
+
+		// read the separator
+		switch {
+		case i == len(s):
+			return column, nil
+		case strings.HasPrefix(s[i:], "->"):
+			inPath = true
+			i += 2
+		case s[i] == '.' && !inPath:
+			i++
+		default:
+			return nil, fmt.Errorf("expr: unexpected character %q in column %q", s[i], s)
+		}
+	}
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '$' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// fieldColumn returns the column of a field. Fields without an explicit
+// column are mapped to the column named after the field, nested fields such
+// as "apple_hub.apple_key.owner_name" being looked up as JSON paths within
+// the column named after their first element.
+func fieldColumn(field *Field) (*Column, error) {
+	if field.Column != nil {
+		return field.Column, nil
+	}
+	path := strings.Split(field.Name, ".")
+	for _, key := range path {
+		if key == "" {
+			return nil, errors.New("expr: invalid field name")
+		}
+	}
+	return &Column{Name: path[:1], Path: path[1:]}, nil
+}
@@ -0,0 +1,77 @@
+package expr
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseColumn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    *Column
+		wantErr bool
+	}{
+		{
+			name:  "column",
+			input: "display_name",
+			want:  &Column{Name: []string{"display_name"}},
+		},
+		{
+			name:  "qualified column",
+			input: "public.users.display_name",
+			want:  &Column{Name: []string{"public", "users", "display_name"}},
+		},
+		{
+			name:  "quoted column",
+			input: `u."Display ""Name"""`,
+			want:  &Column{Name: []string{"u", `Display "Name"`}},
+		},
+		{
+			name:  "json path",
+			input: `profile->address->"zip.code"`,
+			want:  &Column{Name: []string{"profile"}, Path: []string{"address", "zip.code"}},
+		},
+		{
+			name:    "empty",
+			input:   "",
+			wantErr: true,
+		},
+		{
+			name:    "unterminated quote",
+			input:   `"display_name`,
+			wantErr: true,
+		},
+		{
+			name:    "dot inside json path",
+			input:   "profile->address.zip",
+			wantErr: true,
+		},
+		{
+			name:    "missing json path key",
+			input:   "profile->",
+			wantErr: true,
+		},
+		{
+			name:    "unexpected character",
+			input:   "display name",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseColumn(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseColumn() error: %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseColumn() got: %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
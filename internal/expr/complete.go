@@ -0,0 +1,404 @@
+package expr
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SuggestionKind classifies completion suggestions.
+type SuggestionKind int
+
+// Suggestion kinds.
+const (
+	FieldSuggestionKind SuggestionKind = iota
+	OperatorSuggestionKind
+	MethodSuggestionKind
+	FunctionSuggestionKind
+	LiteralSuggestionKind
+)
+
+var suggestionKindNames = map[SuggestionKind]string{
+	FieldSuggestionKind:    "field",
+	OperatorSuggestionKind: "operator",
+	MethodSuggestionKind:   "method",
+	FunctionSuggestionKind: "function",
+	LiteralSuggestionKind:  "literal",
+}
+
+// String returns the name of the suggestion kind.
+func (k SuggestionKind) String() string {
+	if name, ok := suggestionKindNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("SuggestionKind(%d)", int(k))
+}
+
+// Suggestion is a completion of a partial expression.
+type Suggestion struct {
+	Kind SuggestionKind
+	// Text is the text to insert.
+	Text string
+	// Detail describes the suggestion, such as the type of a field.
+	Detail string
+	// Start and End are the byte offsets of the text replaced by the
+	// suggestion.
+	Start int
+	End   int
+}
+
+// Complete returns the suggestions to complete the partial filter at the
+// given byte offset, best first. Completion is lexical, so it works on
+// expressions that don't parse yet.
+func (p *Parser) Complete(filter string, cursor int) []*Suggestion {
+	if cursor < 0 || cursor > len(filter) {
+		cursor = len(filter)
+	}
+
+	// find the word being typed around the cursor
+	start := cursor
+	for start > 0 && isWordChar(filter[start-1]) {
+		start--
+	}
+	end := cursor
+	for end < len(filter) && isIdentChar(filter[end]) {
+		end++
+	}
+
+	// an operator being typed is completed as a word, such as = into ==,
+	// unless nothing completes it, such as the ! of !active
+	if start == cursor {
+		opStart := start
+		for opStart > 0 && strings.IndexByte("=!<>&|", filter[opStart-1]) >= 0 {
+			opStart--
+		}
+		switch filter[opStart:cursor] {
+		case "", OperatorEquals, OperatorNotEquals, OperatorLess, OperatorLessEquals, OperatorGreater,
+			OperatorGreaterEquals, OperatorAnd, OperatorOr:
+		default:
+			if suggestions := p.complete(filter, opStart, cursor, end); len(suggestions) > 0 {
+				return suggestions
+			}
+		}
+	}
+	return p.complete(filter, start, cursor, end)
+}
+
+// complete returns the suggestions replacing the word found between start
+// and end, typed up to the cursor.
+func (p *Parser) complete(filter string, start, cursor, end int) []*Suggestion {
+	word := filter[start:cursor]
+	tokens, ok := completionTokens(filter[:start])
+	if !ok {
+		// nothing to suggest within string literals
+		return nil
+	}
+
+	// methods are completed after the dot of a field
+	if i := strings.LastIndexByte(word, '.'); i >= 0 {
+		if field, ok := p.fields[word[:i]]; ok {
+			c := &completer{start: start + i + 1, end: end}
+			for _, op := range p.Operators(field.Ftype) {
+				if isMethod(op) {
+					c.add(MethodSuggestionKind, op, "")
+				}
+			}
+			return c.rank(word[i+1:])
+		}
+	}
+
+	c := &completer{start: start, end: end}
+	var last string
+	if len(tokens) > 0 {
+		last = tokens[len(tokens)-1].text
+	}
+	switch {
+	case len(tokens) == 0 || last == "(" && !isCall(tokens, OperatorPresent, OperatorSize) || last == OperatorNot || last == OperatorAnd || last == OperatorOr:
+		p.suggestOperands(c)
+	case last == "(":
+		// arguments of present and size are fields accepting them
+		function := tokens[len(tokens)-2].text
+		for _, field := range p.Fields() {
+			if containsString(p.Operators(field.Ftype), function) {
+				c.add(FieldSuggestionKind, field.Name, field.Ftype.String())
+			}
+		}
+	case isComparison(last):
+		if ftype, ok := p.operandType(tokens[:len(tokens)-1]); ok {
+			p.suggestValues(c, ftype, last)
+		}
+	case last == "[" || last == ",":
+		if ftype, ok := p.listOperandType(tokens); ok {
+			p.suggestValues(c, ftype, "")
+		}
+	default:
+		lastKind := tokens[len(tokens)-1].kind
+		ftype, isOperand := p.operandType(tokens)
+		if isOperand {
+			p.suggestOperators(c, ftype, lastKind == identToken)
+		}
+		// conditions are complete after values, calls and boolean fields
+		if lastKind == literalToken || last == "]" || last == ")" && !isOperand ||
+			isOperand && lastKind == identToken && ftype == BoolFieldType {
+			c.add(OperatorSuggestionKind, OperatorAnd, "")
+			c.add(OperatorSuggestionKind, OperatorOr, "")
+		}
+	}
+	return c.rank(word)
+}
+
+// suggestOperands suggests what can start a condition: fields and the
+// present and size functions.
+func (p *Parser) suggestOperands(c *completer) {
+	functions := make(map[string]bool)
+	for _, field := range p.Fields() {
+		c.add(FieldSuggestionKind, field.Name, field.Ftype.String())
+		for _, op := range p.Operators(field.Ftype) {
+			if op == OperatorPresent || op == OperatorSize {
+				functions[op] = true
+			}
+		}
+	}
+	for _, function := range []string{OperatorPresent, OperatorSize} {
+		if functions[function] {
+			c.add(FunctionSuggestionKind, function+"(", "")
+		}
+	}
+}
+
+// suggestOperators suggests the operators and methods valid for an operand
+// of the given type. Methods are only suggested after fields.
+func (p *Parser) suggestOperators(c *completer, ftype FieldType, isField bool) {
+	for _, op := range p.Operators(ftype) {
+		switch {
+		case op == OperatorPresent || op == OperatorSize:
+		case isMethod(op):
+			if isField {
+				c.add(MethodSuggestionKind, "."+op+"(", "")
+			}
+		default:
+			c.add(OperatorSuggestionKind, op, "")
+		}
+	}
+}
+
+// suggestValues suggests the literals of the given type worth completing
+// after the given operator.
+func (p *Parser) suggestValues(c *completer, ftype FieldType, op string) {
+	if op == OperatorIn {
+		c.add(LiteralSuggestionKind, "[", "")
+		return
+	}
+	switch ftype {
+	case BoolFieldType:
+		c.add(LiteralSuggestionKind, "true", "")
+		c.add(LiteralSuggestionKind, "false", "")
+	case TimestampFieldType:
+		c.add(LiteralSuggestionKind, functionNow+"()", "")
+		c.add(LiteralSuggestionKind, "timestamp('')", "")
+	}
+	if op == OperatorEquals || op == OperatorNotEquals {
+		c.add(LiteralSuggestionKind, "null", "")
+	}
+}
+
+// operandType returns the type of the operand the tokens end with, a field
+// or the size of one.
+func (p *Parser) operandType(tokens []*completionToken) (FieldType, bool) {
+	n := len(tokens)
+	switch {
+	case n >= 1 && tokens[n-1].kind == identToken:
+		if field, ok := p.fields[tokens[n-1].text]; ok {
+			return field.Ftype, true
+		}
+	case n >= 4 && tokens[n-1].text == ")" && tokens[n-3].text == "(" && tokens[n-4].text == OperatorSize:
+		if _, ok := p.fields[tokens[n-2].text]; ok {
+			return IntegerFieldType, true
+		}
+	}
+	return 0, false
+}
+
+// listOperandType returns the type of the operand of the in list the tokens
+// are within.
+func (p *Parser) listOperandType(tokens []*completionToken) (FieldType, bool) {
+	depth := 0
+	for i := len(tokens) - 1; i > 0; i-- {
+		switch tokens[i].text {
+		case "[":
+			if tokens[i-1].text != OperatorIn {
+				return 0, false
+			}
+			return p.operandType(tokens[:i-1])
+		case ")":
+			depth++
+		case "(":
+			// skip calls within the list, such as now()
+			if depth--; depth < 0 {
+				return 0, false
+			}
+		case "]":
+			return 0, false
+		}
+	}
+	return 0, false
+}
+
+// completer collects suggestions replacing the same text.
+type completer struct {
+	start       int
+	end         int
+	suggestions []*Suggestion
+}
+
+func (c *completer) add(kind SuggestionKind, text, detail string) {
+	c.suggestions = append(c.suggestions, &Suggestion{
+		Kind:   kind,
+		Text:   text,
+		Detail: detail,
+		Start:  c.start,
+		End:    c.end,
+	})
+}
+
+// rank returns the suggestions matching the word being typed, those starting
+// with it first, then those starting with it ignoring case, then those
+// containing it.
+func (c *completer) rank(word string) []*Suggestion {
+	lowerWord := strings.ToLower(word)
+	rankOf := func(s *Suggestion) int {
+		text := strings.TrimPrefix(s.Text, ".")
+		switch {
+		case strings.HasPrefix(text, word):
+			return 0
+		case strings.HasPrefix(strings.ToLower(text), lowerWord):
+			return 1
+		case strings.Contains(strings.ToLower(text), lowerWord):
+			return 2
+		default:
+			return -1
+		}
+	}
+	var ranked []*Suggestion
+	for _, s := range c.suggestions {
+		if rankOf(s) >= 0 {
+			ranked = append(ranked, s)
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return rankOf(ranked[i]) < rankOf(ranked[j])
+	})
+	return ranked
+}
+
+// completionTokenKind classifies the tokens of a partial expression.
+type completionTokenKind int
+
+const (
+	identToken completionTokenKind = iota
+	literalToken
+	operatorToken
+)
+
+// completionToken is a token of a partial expression.
+type completionToken struct {
+	kind completionTokenKind
+	text string
+}
+
+// completionTokens splits the partial filter into tokens, reporting false if
+// it ends within a string literal.
+func completionTokens(filter string) ([]*completionToken, bool) {
+	var tokens []*completionToken
+	for i := 0; i < len(filter); {
+		c := filter[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '\'' || c == '"':
+			end := i + 1
+			for end < len(filter) && filter[end] != c {
+				if filter[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(filter) {
+				return nil, false
+			}
+			end++
+			tokens = append(tokens, &completionToken{kind: literalToken, text: filter[i:end]})
+			i = end
+		case c >= '0' && c <= '9':
+			end := tokenEnd(filter, i)
+			tokens = append(tokens, &completionToken{kind: literalToken, text: filter[i:end]})
+			i = end
+		case isWordChar(c):
+			end := i
+			for end < len(filter) && isWordChar(filter[end]) {
+				end++
+			}
+			word := filter[i:end]
+			i = end
+			// string and bytes prefixes
+			if (word == "b" || word == "r" || word == "B" || word == "R") && i < len(filter) && (filter[i] == '\'' || filter[i] == '"') {
+				continue
+			}
+			kind := identToken
+			switch word {
+			case "true", "false", "null":
+				kind = literalToken
+			case OperatorIn:
+				kind = operatorToken
+			}
+			tokens = append(tokens, &completionToken{kind: kind, text: word})
+		default:
+			text := filter[i : i+1]
+			if i+1 < len(filter) {
+				switch op := filter[i : i+2]; op {
+				case "==", "!=", "<=", ">=", "&&", "||":
+					text = op
+				}
+			}
+			tokens = append(tokens, &completionToken{kind: operatorToken, text: text})
+			i += len(text)
+		}
+	}
+	return tokens, true
+}
+
+// isWordChar reports whether c is part of a, possibly dotted, identifier.
+func isWordChar(c byte) bool {
+	return isIdentChar(c) || c == '.'
+}
+
+// isCall reports whether the tokens end with the opening parenthesis of a
+// call to any of the functions.
+func isCall(tokens []*completionToken, functions ...string) bool {
+	if len(tokens) < 2 || tokens[len(tokens)-1].text != "(" {
+		return false
+	}
+	return containsString(functions, tokens[len(tokens)-2].text)
+}
+
+func isComparison(op string) bool {
+	switch op {
+	case OperatorEquals, OperatorNotEquals, OperatorLess, OperatorLessEquals, OperatorGreater, OperatorGreaterEquals, OperatorIn:
+		return true
+	default:
+		return false
+	}
+}
+
+func isMethod(op string) bool {
+	return op == OperatorStartsWith || op == OperatorEndsWith || op == OperatorContains
+}
+
+func containsString(values []string, s string) bool {
+	for _, value := range values {
+		if value == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,192 @@
+package expr
+
+import (
+	"reflect"
+	"testing"
+
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+func TestComplete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		input  string
+		cursor int
+		want   []*Suggestion
+	}{
+		{
+			name:  "fields and functions at the start",
+			input: "",
+			want: []*Suggestion{
+				{Kind: FieldSuggestionKind, Text: "active", Detail: "bool"},
+				{Kind: FieldSuggestionKind, Text: "age", Detail: "integer"},
+				{Kind: FieldSuggestionKind, Text: "created_at", Detail: "timestamp"},
+				{Kind: FieldSuggestionKind, Text: "email", Detail: "string"},
+				{Kind: FieldSuggestionKind, Text: "tags", Detail: "string_array"},
+				{Kind: FunctionSuggestionKind, Text: "present("},
+				{Kind: FunctionSuggestionKind, Text: "size("},
+			},
+		},
+		{
+			name:  "fields after logical operator ranked by prefix",
+			input: "age > 1 && A",
+			want: []*Suggestion{
+				{Kind: FieldSuggestionKind, Text: "active", Detail: "bool", Start: 11, End: 12},
+				{Kind: FieldSuggestionKind, Text: "age", Detail: "integer", Start: 11, End: 12},
+				{Kind: FieldSuggestionKind, Text: "created_at", Detail: "timestamp", Start: 11, End: 12},
+				{Kind: FieldSuggestionKind, Text: "email", Detail: "string", Start: 11, End: 12},
+				{Kind: FieldSuggestionKind, Text: "tags", Detail: "string_array", Start: 11, End: 12},
+			},
+		},
+		{
+			name:   "word in the middle of the expression",
+			input:  "emx == 'a'",
+			cursor: 2,
+			want: []*Suggestion{
+				{Kind: FieldSuggestionKind, Text: "email", Detail: "string", Start: 0, End: 3},
+			},
+		},
+		{
+			name:  "operators and methods after a field",
+			input: "email ",
+			want: []*Suggestion{
+				{Kind: OperatorSuggestionKind, Text: "==", Start: 6, End: 6},
+				{Kind: OperatorSuggestionKind, Text: "!=", Start: 6, End: 6},
+				{Kind: OperatorSuggestionKind, Text: "in", Start: 6, End: 6},
+				{Kind: MethodSuggestionKind, Text: ".contains(", Start: 6, End: 6},
+				{Kind: MethodSuggestionKind, Text: ".endsWith(", Start: 6, End: 6},
+				{Kind: MethodSuggestionKind, Text: ".startsWith(", Start: 6, End: 6},
+			},
+		},
+		{
+			name:  "methods after the dot of a field",
+			input: "email.s",
+			want: []*Suggestion{
+				{Kind: MethodSuggestionKind, Text: "startsWith", Start: 6, End: 7},
+				{Kind: MethodSuggestionKind, Text: "contains", Start: 6, End: 7},
+				{Kind: MethodSuggestionKind, Text: "endsWith", Start: 6, End: 7},
+			},
+		},
+		{
+			name:  "incomplete operator",
+			input: "age >= 1 && age !",
+			want: []*Suggestion{
+				{Kind: OperatorSuggestionKind, Text: "!=", Start: 16, End: 17},
+			},
+		},
+		{
+			name:  "operators and logical operators after a boolean field",
+			input: "active ",
+			want: []*Suggestion{
+				{Kind: OperatorSuggestionKind, Text: "==", Start: 7, End: 7},
+				{Kind: OperatorSuggestionKind, Text: "&&", Start: 7, End: 7},
+				{Kind: OperatorSuggestionKind, Text: "||", Start: 7, End: 7},
+			},
+		},
+		{
+			name:  "fields after not",
+			input: "age > 1 && !a",
+			want: []*Suggestion{
+				{Kind: FieldSuggestionKind, Text: "active", Detail: "bool", Start: 12, End: 13},
+				{Kind: FieldSuggestionKind, Text: "age", Detail: "integer", Start: 12, End: 13},
+				{Kind: FieldSuggestionKind, Text: "created_at", Detail: "timestamp", Start: 12, End: 13},
+				{Kind: FieldSuggestionKind, Text: "email", Detail: "string", Start: 12, End: 13},
+				{Kind: FieldSuggestionKind, Text: "tags", Detail: "string_array", Start: 12, End: 13},
+			},
+		},
+		{
+			name:  "boolean literals",
+			input: "active == ",
+			want: []*Suggestion{
+				{Kind: LiteralSuggestionKind, Text: "true", Start: 10, End: 10},
+				{Kind: LiteralSuggestionKind, Text: "false", Start: 10, End: 10},
+				{Kind: LiteralSuggestionKind, Text: "null", Start: 10, End: 10},
+			},
+		},
+		{
+			name:  "timestamp literals",
+			input: "created_at > n",
+			want: []*Suggestion{
+				{Kind: LiteralSuggestionKind, Text: "now()", Start: 13, End: 14},
+			},
+		},
+		{
+			name:  "timestamp literals within in list",
+			input: "created_at in [now(), ",
+			want: []*Suggestion{
+				{Kind: LiteralSuggestionKind, Text: "now()", Start: 22, End: 22},
+				{Kind: LiteralSuggestionKind, Text: "timestamp('')", Start: 22, End: 22},
+			},
+		},
+		{
+			name:  "integer operators after size",
+			input: "size(tags) ",
+			want: []*Suggestion{
+				{Kind: OperatorSuggestionKind, Text: "==", Start: 11, End: 11},
+				{Kind: OperatorSuggestionKind, Text: "!=", Start: 11, End: 11},
+				{Kind: OperatorSuggestionKind, Text: "in", Start: 11, End: 11},
+				{Kind: OperatorSuggestionKind, Text: "<", Start: 11, End: 11},
+				{Kind: OperatorSuggestionKind, Text: "<=", Start: 11, End: 11},
+				{Kind: OperatorSuggestionKind, Text: ">", Start: 11, End: 11},
+				{Kind: OperatorSuggestionKind, Text: ">=", Start: 11, End: 11},
+			},
+		},
+		{
+			name:  "fields accepted by size",
+			input: "size(",
+			want: []*Suggestion{
+				{Kind: FieldSuggestionKind, Text: "tags", Detail: "string_array", Start: 5, End: 5},
+			},
+		},
+		{
+			name:  "logical operators after a condition",
+			input: "email.startsWith('a') ",
+			want: []*Suggestion{
+				{Kind: OperatorSuggestionKind, Text: "&&", Start: 22, End: 22},
+				{Kind: OperatorSuggestionKind, Text: "||", Start: 22, End: 22},
+			},
+		},
+		{
+			name:  "nothing within string literals",
+			input: "email == 'ag",
+		},
+	}
+
+	parser, err := NewParser(map[string]*exprpb.Type{
+		"email":      {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+		"age":        {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_INT64}},
+		"active":     {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_BOOL}},
+		"created_at": {TypeKind: &exprpb.Type_WellKnown{WellKnown: exprpb.Type_TIMESTAMP}},
+		"tags": {TypeKind: &exprpb.Type_ListType_{ListType: &exprpb.Type_ListType{
+			ElemType: &exprpb.Type{TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+		}}},
+	})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cursor := tt.cursor
+			if cursor == 0 {
+				cursor = len(tt.input)
+			}
+			got := parser.Complete(tt.input, cursor)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Complete() got: %v, want %v", suggestionTexts(got), suggestionTexts(tt.want))
+			}
+		})
+	}
+}
+
+func suggestionTexts(suggestions []*Suggestion) []string {
+	texts := make([]string, len(suggestions))
+	for i, s := range suggestions {
+		texts[i] = s.Text
+	}
+	return texts
+}
@@ -0,0 +1,317 @@
+package expr
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Dialect defines how the SQL clause is rendered for a specific database.
+type Dialect interface {
+	// Placeholder returns the placeholder of the n-th arg, starting at 1.
+	Placeholder(n int) string
+	// QuoteIdentifier returns the quoted identifier.
+	QuoteIdentifier(name string) string
+	// JSONPath returns the expression extracting, as text, the value found at
+	// path inside the JSON column.
+	JSONPath(column string, path []string) string
+	// Cast returns the expression converting expr to the given field type.
+	Cast(expr string, ftype FieldType) string
+	// Lower returns the case folded expression.
+	Lower(expr string) string
+	// Like returns the expression matching expr against a LIKE pattern using
+	// backslash as escape character, declared by an ESCAPE clause so it
+	// doesn't depend on the settings of the server.
+	Like(expr, pattern string) string
+	// ArrayContains returns the expression checking if the array column
+	// contains elem.
+	ArrayContains(column, elem string) string
+	// ArrayLength returns the expression computing the number of elements of
+	// the array column.
+	ArrayLength(column string) string
+	// IsTrue returns the predicate checking if the boolean expr is true.
+	IsTrue(expr string) string
+}
+
+// Built-in dialects.
+var (
+	PostgreSQL  Dialect = postgreSQL{}
+	MySQL       Dialect = mySQL{}
+	SQLite      Dialect = sqLite{}
+	SQLServer   Dialect = sqlServer{}
+	CockroachDB Dialect = cockroachDB{}
+)
+
+// DefaultDialect is the dialect used when no other is specified.
+var DefaultDialect = CockroachDB
+
+// postgreSQL renders PostgreSQL compatible SQL, storing string arrays as
+// native text arrays.
+type postgreSQL struct{}
+
+func (postgreSQL) Placeholder(n int) string {
+	return "$" + strconv.Itoa(n)
+}
+
+func (postgreSQL) QuoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func (postgreSQL) JSONPath(column string, path []string) string {
+	for i, key := range path {
+		if i == len(path)-1 {
+			column += "->>" + quoteString(key)
+		} else {
+			column += "->" + quoteString(key)
+		}
+	}
+	return column
+}
+
+func (postgreSQL) Cast(expr string, ftype FieldType) string {
+	switch ftype {
+	case BoolFieldType:
+		return fmt.Sprintf("(%s)::BOOLEAN", expr)
+	case IntegerFieldType:
+		return fmt.Sprintf("(%s)::BIGINT", expr)
+	case DoubleFieldType:
+		return fmt.Sprintf("(%s)::DOUBLE PRECISION", expr)
+	case BytesFieldType:
+		return fmt.Sprintf("(%s)::BYTEA", expr)
+	case TimestampFieldType:
+		return fmt.Sprintf("(%s)::TIMESTAMPTZ", expr)
+	default:
+		return expr
+	}
+}
+
+func (postgreSQL) Lower(expr string) string {
+	return fmt.Sprintf("LOWER(%s)", expr)
+}
+
+// Like declares the escape character as an escape string constant, which is
+// a backslash whether standard_conforming_strings is on or off.
+func (postgreSQL) Like(expr, pattern string) string {
+	return fmt.Sprintf(`%s LIKE %s ESCAPE E'\\'`, expr, pattern)
+}
+
+func (postgreSQL) ArrayContains(column, elem string) string {
+	return fmt.Sprintf("%s @> ARRAY[%s]", column, elem)
+}
+
+func (postgreSQL) ArrayLength(column string) string {
+	return fmt.Sprintf("cardinality(%s)", column)
+}
+
+func (postgreSQL) IsTrue(expr string) string {
+	return expr
+}
+
+// cockroachDB renders CockroachDB compatible SQL. It shares most of its syntax
+// with PostgreSQL, except for type names and array functions.
+type cockroachDB struct {
+	postgreSQL
+}
+
+func (cockroachDB) Cast(expr string, ftype FieldType) string {
+	switch ftype {
+	case BoolFieldType:
+		return fmt.Sprintf("(%s)::BOOL", expr)
+	case IntegerFieldType:
+		return fmt.Sprintf("(%s)::INT", expr)
+	case DoubleFieldType:
+		return fmt.Sprintf("(%s)::FLOAT", expr)
+	case BytesFieldType:
+		return fmt.Sprintf("(%s)::BYTES", expr)
+	case TimestampFieldType:
+		return fmt.Sprintf("(%s)::TIMESTAMP", expr)
+	default:
+		return expr
+	}
+}
+
+func (cockroachDB) ArrayLength(column string) string {
+	return fmt.Sprintf("COALESCE(array_length(%s, 1), 0)", column)
+}
+
+// mySQL renders MySQL compatible SQL, storing string arrays as JSON arrays.
+type mySQL struct{}
+
+func (mySQL) Placeholder(int) string {
+	return "?"
+}
+
+func (mySQL) QuoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func (mySQL) JSONPath(column string, path []string) string {
+	return fmt.Sprintf("JSON_UNQUOTE(JSON_EXTRACT(%s, %s))", column, quoteString(jsonPath(path)))
+}
+
+func (mySQL) Cast(expr string, ftype FieldType) string {
+	switch ftype {
+	case BoolFieldType:
+		// JSON booleans are unquoted as 'true' or 'false', which can't be cast.
+		return fmt.Sprintf("(%s = 'true')", expr)
+	case IntegerFieldType:
+		return fmt.Sprintf("CAST(%s AS SIGNED)", expr)
+	case DoubleFieldType:
+		return fmt.Sprintf("CAST(%s AS DOUBLE)", expr)
+	case BytesFieldType:
+		return fmt.Sprintf("CAST(%s AS BINARY)", expr)
+	case TimestampFieldType:
+		return fmt.Sprintf("CAST(%s AS DATETIME(6))", expr)
+	default:
+		return expr
+	}
+}
+
+func (mySQL) Lower(expr string) string {
+	return fmt.Sprintf("LOWER(%s)", expr)
+}
+
+// Like declares the escape character by its code, as a backslash literal is
+// written differently depending on the NO_BACKSLASH_ESCAPES mode.
+func (mySQL) Like(expr, pattern string) string {
+	return fmt.Sprintf("%s LIKE %s ESCAPE CHAR(92)", expr, pattern)
+}
+
+func (mySQL) ArrayContains(column, elem string) string {
+	return fmt.Sprintf("JSON_CONTAINS(%s, JSON_QUOTE(%s))", column, elem)
+}
+
+func (mySQL) ArrayLength(column string) string {
+	return fmt.Sprintf("JSON_LENGTH(%s)", column)
+}
+
+func (mySQL) IsTrue(expr string) string {
+	return fmt.Sprintf("%s = TRUE", expr)
+}
+
+// sqLite renders SQLite compatible SQL, storing string arrays as JSON arrays.
+type sqLite struct{}
+
+func (sqLite) Placeholder(int) string {
+	return "?"
+}
+
+func (sqLite) QuoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func (sqLite) JSONPath(column string, path []string) string {
+	return fmt.Sprintf("json_extract(%s, %s)", column, quoteString(jsonPath(path)))
+}
+
+func (sqLite) Cast(expr string, ftype FieldType) string {
+	switch ftype {
+	case BoolFieldType, IntegerFieldType:
+		return fmt.Sprintf("CAST(%s AS INTEGER)", expr)
+	case DoubleFieldType:
+		return fmt.Sprintf("CAST(%s AS REAL)", expr)
+	case BytesFieldType:
+		return fmt.Sprintf("CAST(%s AS BLOB)", expr)
+	default:
+		// SQLite has no timestamp type, timestamps are stored as text.
+		return expr
+	}
+}
+
+func (sqLite) Lower(expr string) string {
+	return fmt.Sprintf("LOWER(%s)", expr)
+}
+
+func (sqLite) Like(expr, pattern string) string {
+	return fmt.Sprintf(`%s LIKE %s ESCAPE '\'`, expr, pattern)
+}
+
+func (sqLite) ArrayContains(column, elem string) string {
+	return fmt.Sprintf("EXISTS (SELECT 1 FROM json_each(%s) WHERE value = %s)", column, elem)
+}
+
+func (sqLite) ArrayLength(column string) string {
+	return fmt.Sprintf("json_array_length(%s)", column)
+}
+
+func (sqLite) IsTrue(expr string) string {
+	return expr
+}
+
+// sqlServer renders SQL Server compatible SQL, storing string arrays as JSON
+// arrays.
+type sqlServer struct{}
+
+func (sqlServer) Placeholder(n int) string {
+	return "@p" + strconv.Itoa(n)
+}
+
+func (sqlServer) QuoteIdentifier(name string) string {
+	return "[" + strings.ReplaceAll(name, "]", "]]") + "]"
+}
+
+func (sqlServer) JSONPath(column string, path []string) string {
+	return fmt.Sprintf("JSON_VALUE(%s, %s)", column, quoteString(jsonPath(path)))
+}
+
+func (sqlServer) Cast(expr string, ftype FieldType) string {
+	switch ftype {
+	case BoolFieldType:
+		return fmt.Sprintf("CAST(%s AS BIT)", expr)
+	case IntegerFieldType:
+		return fmt.Sprintf("CAST(%s AS BIGINT)", expr)
+	case DoubleFieldType:
+		return fmt.Sprintf("CAST(%s AS FLOAT)", expr)
+	case BytesFieldType:
+		return fmt.Sprintf("CAST(%s AS VARBINARY(MAX))", expr)
+	case TimestampFieldType:
+		return fmt.Sprintf("CAST(%s AS DATETIMEOFFSET)", expr)
+	default:
+		return expr
+	}
+}
+
+func (sqlServer) Lower(expr string) string {
+	return fmt.Sprintf("LOWER(%s)", expr)
+}
+
+func (sqlServer) Like(expr, pattern string) string {
+	return fmt.Sprintf(`%s LIKE %s ESCAPE '\'`, expr, pattern)
+}
+
+func (sqlServer) ArrayContains(column, elem string) string {
+	return fmt.Sprintf("EXISTS (SELECT 1 FROM OPENJSON(%s) WHERE value = %s)", column, elem)
+}
+
+func (sqlServer) ArrayLength(column string) string {
+	return fmt.Sprintf("(SELECT COUNT(*) FROM OPENJSON(%s))", column)
+}
+
+func (sqlServer) IsTrue(expr string) string {
+	// SQL Server has no boolean type, BIT columns are compared against 1.
+	return fmt.Sprintf("%s = 1", expr)
+}
+
+// quoteString returns s as a SQL string literal.
+func quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+var jsonPathKeyRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// jsonPath returns the SQL/JSON path expression of the given keys, for
+// example "$.company.name".
+func jsonPath(path []string) string {
+	var sb strings.Builder
+	sb.WriteString("$")
+	for _, key := range path {
+		sb.WriteString(".")
+		if jsonPathKeyRe.MatchString(key) {
+			sb.WriteString(key)
+		} else {
+			sb.WriteString(strconv.Quote(key))
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,394 @@
+package expr
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+// ErrorKind classifies parse errors.
+type ErrorKind int
+
+// Error kinds.
+const (
+	// SyntaxErrorKind reports a malformed expression.
+	SyntaxErrorKind ErrorKind = iota
+	// UnknownFieldErrorKind reports a reference to a field not declared.
+	UnknownFieldErrorKind
+	// TypeMismatchErrorKind reports an operator applied to arguments of the
+	// wrong type.
+	TypeMismatchErrorKind
+	// InvalidValueErrorKind reports a literal that can't be converted, such
+	// as a malformed timestamp.
+	InvalidValueErrorKind
+	// UnsupportedErrorKind reports a valid CEL expression that has no SQL
+	// translation.
+	UnsupportedErrorKind
+	// DepthLimitErrorKind reports an expression nested too deep.
+	DepthLimitErrorKind
+)
+
+var errorKindNames = map[ErrorKind]string{
+	SyntaxErrorKind:       "syntax",
+	UnknownFieldErrorKind: "unknown_field",
+	TypeMismatchErrorKind: "type_mismatch",
+	InvalidValueErrorKind: "invalid_value",
+	UnsupportedErrorKind:  "unsupported",
+	DepthLimitErrorKind:   "depth_limit",
+}
+
+// String returns the name of the error kind.
+func (k ErrorKind) String() string {
+	if name, ok := errorKindNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("ErrorKind(%d)", int(k))
+}
+
+// Error is an error found parsing an expression, located within its text.
+type Error struct {
+	Kind ErrorKind
+	Msg  string
+	// Offset is the byte offset of the error within the expression, -1 if
+	// unknown, and End the byte offset right after the text at fault.
+	Offset int
+	End    int
+	// Line and Column are the 1-based position of the error, in characters,
+	// 0 if unknown.
+	Line   int
+	Column int
+	// Field and Operator are the field and operator at fault, if any.
+	Field    string
+	Operator string
+	// Suggestions holds the names of the known fields close to an unknown
+	// one.
+	Suggestions []string
+
+	// id of the expression at fault, used to locate the error once the
+	// walk is over.
+	id int64
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	if e.Line == 0 {
+		return "expr: " + e.Msg
+	}
+	return fmt.Sprintf("expr: %s (line %d, column %d)", e.Msg, e.Line, e.Column)
+}
+
+// newError returns an error of the given kind at the given expression.
+func newError(kind ErrorKind, expr *exprpb.Expr, format string, args ...any) *Error {
+	return &Error{
+		Kind:   kind,
+		Msg:    fmt.Sprintf(format, args...),
+		Offset: -1,
+		End:    -1,
+		id:     expr.GetId(),
+	}
+}
+
+// locate sets the position of the error from the position of its
+// expression.
+func (e *Error) locate(filter string, sourceInfo *exprpb.SourceInfo) {
+	if e.id == 0 {
+		return
+	}
+	if offset, ok := sourceInfo.GetPositions()[e.id]; ok {
+		e.setOffset(filter, int(offset))
+	}
+}
+
+// setOffset sets the position of the error from a character offset.
+func (e *Error) setOffset(filter string, offset int) {
+	e.Offset, e.Line, e.Column = position(filter, offset)
+	e.End = e.Offset
+
+	// span the field or operator at fault, or else the token at the offset
+	for _, text := range []string{e.Field, e.Operator} {
+		if text != "" && strings.HasPrefix(filter[e.Offset:], text) {
+			e.End = e.Offset + len(text)
+			return
+		}
+	}
+	e.End = tokenEnd(filter, e.Offset)
+}
+
+// setSpan sets the position of the error from the byte offsets of the text
+// at fault.
+func (e *Error) setSpan(filter string, offset, end int) {
+	e.setOffset(filter, utf8.RuneCountInString(filter[:offset]))
+	e.End = end
+}
+
+// position converts a character offset to a byte offset and a 1-based line
+// and character column.
+func position(filter string, offset int) (byteOffset, line, column int) {
+	line, column = 1, 1
+	for i, r := range filter {
+		if offset == 0 {
+			return i, line, column
+		}
+		offset--
+		if r == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+	return len(filter), line, column
+}
+
+// tokenEnd returns the byte offset right after the identifier, number or
+// quoted string starting at the given byte offset.
+func tokenEnd(filter string, offset int) int {
+	i := offset
+	if i < len(filter) && (filter[i] == '\'' || filter[i] == '"') {
+		quote := filter[i]
+		for i++; i < len(filter); i++ {
+			switch filter[i] {
+			case '\\':
+				i++
+			case quote:
+				return i + 1
+			}
+		}
+		return len(filter)
+	}
+	for i < len(filter) && (isIdentChar(filter[i]) || filter[i] == '.') {
+		i++
+	}
+	return i
+}
+
+var (
+	undeclaredRe = regexp.MustCompile(`^undeclared reference to '([^']*)'`)
+	overloadRe   = regexp.MustCompile(`^found no matching overload for '([^']*)' applied to '(.*)'`)
+)
+
+// issueError converts the first of the CEL issues to an Error. ast is the
+// parsed expression, nil on syntax errors.
+func (p *Parser) issueError(filter string, ast *cel.Ast, iss *cel.Issues) *Error {
+	errs := iss.Errors()
+	sort.SliceStable(errs, func(i, j int) bool {
+		li, lj := errs[i].Location, errs[j].Location
+		return li.Line() < lj.Line() || li.Line() == lj.Line() && li.Column() < lj.Column()
+	})
+	celErr := errs[0]
+
+	offset := characterOffset(filter, celErr.Location)
+	err := &Error{Msg: strings.TrimPrefix(celErr.Message, "Syntax error: ")}
+	defer err.setOffset(filter, offset)
+	if ast == nil {
+		err.Kind = SyntaxErrorKind
+		return err
+	}
+
+	switch {
+	case undeclaredRe.MatchString(celErr.Message):
+		name := undeclaredRe.FindStringSubmatch(celErr.Message)[1]
+		if qualified := qualifiedNameAt(ast, offset); qualified != "" {
+			name = qualified
+		}
+		err.Kind = UnknownFieldErrorKind
+		err.Field = name
+		err.Msg = fmt.Sprintf("unknown field %s", name)
+		err.Suggestions = p.suggestFields(name)
+	case overloadRe.MatchString(celErr.Message):
+		match := overloadRe.FindStringSubmatch(celErr.Message)
+		err.Kind = TypeMismatchErrorKind
+		err.Operator = operatorName(match[1])
+		err.Field = fieldAt(ast, offset)
+		err.Msg = fmt.Sprintf("operator %s can't be applied to %s", err.Operator, match[2])
+	default:
+		err.Kind = TypeMismatchErrorKind
+	}
+	return err
+}
+
+// characterOffset converts a CEL location, 1-based line and 0-based
+// character column, to a character offset within the filter.
+func characterOffset(filter string, location common.Location) int {
+	offset := 0
+	for line := 1; line < location.Line(); line++ {
+		i := strings.IndexByte(filter, '\n')
+		if i < 0 {
+			break
+		}
+		offset += utf8.RuneCountInString(filter[:i+1])
+		filter = filter[i+1:]
+	}
+	return offset + location.Column()
+}
+
+// operatorName returns the expr operator of a CEL function, such as == for
+// _==_ or ! for !_.
+func operatorName(function string) string {
+	return strings.Trim(strings.Trim(function, "_"), "@")
+}
+
+// qualifiedName returns the dotted name of an identifier or field selection,
+// empty if the expression is neither.
+func qualifiedName(expr *exprpb.Expr) string {
+	switch kind := expr.GetExprKind().(type) {
+	case *exprpb.Expr_IdentExpr:
+		return kind.IdentExpr.GetName()
+	case *exprpb.Expr_SelectExpr:
+		operand := qualifiedName(kind.SelectExpr.GetOperand())
+		if operand == "" {
+			return ""
+		}
+		return operand + "." + kind.SelectExpr.GetField()
+	default:
+		return ""
+	}
+}
+
+// qualifiedNameAt returns the longest dotted name starting at the given
+// character offset of the parsed expression.
+func qualifiedNameAt(ast *cel.Ast, offset int) string {
+	positions := ast.SourceInfo().GetPositions()
+	var longest string
+	inspectExpr(ast.Expr(), func(expr *exprpb.Expr) {
+		name := qualifiedName(expr)
+		if len(name) <= len(longest) {
+			return
+		}
+		// the position of a selection is the one of its dot, look at its root
+		root := expr
+		for {
+			selectExpr, ok := root.GetExprKind().(*exprpb.Expr_SelectExpr)
+			if !ok {
+				break
+			}
+			root = selectExpr.SelectExpr.GetOperand()
+		}
+		if int(positions[root.GetId()]) == offset {
+			longest = name
+		}
+	})
+	return longest
+}
+
+// fieldAt returns the field operated by the call found at the given character
+// offset of the parsed expression, if any.
+func fieldAt(ast *cel.Ast, offset int) string {
+	positions := ast.SourceInfo().GetPositions()
+	var field string
+	inspectExpr(ast.Expr(), func(expr *exprpb.Expr) {
+		callExpr, ok := expr.GetExprKind().(*exprpb.Expr_CallExpr)
+		if !ok || field != "" || int(positions[expr.GetId()]) != offset {
+			return
+		}
+		operands := callExpr.CallExpr.GetArgs()
+		if target := callExpr.CallExpr.GetTarget(); target != nil {
+			operands = append([]*exprpb.Expr{target}, operands...)
+		}
+		for _, operand := range operands {
+			// size(field) is operated as the field itself
+			if sizeExpr, ok := operand.GetExprKind().(*exprpb.Expr_CallExpr); ok && len(sizeExpr.CallExpr.GetArgs()) == 1 {
+				operand = sizeExpr.CallExpr.GetArgs()[0]
+			}
+			if name := qualifiedName(operand); name != "" {
+				field = name
+				return
+			}
+		}
+	})
+	return field
+}
+
+// inspectExpr calls f for every expression of the tree, depth first.
+func inspectExpr(expr *exprpb.Expr, f func(*exprpb.Expr)) {
+	if expr == nil {
+		return
+	}
+	f(expr)
+	switch kind := expr.GetExprKind().(type) {
+	case *exprpb.Expr_SelectExpr:
+		inspectExpr(kind.SelectExpr.GetOperand(), f)
+	case *exprpb.Expr_CallExpr:
+		inspectExpr(kind.CallExpr.GetTarget(), f)
+		for _, arg := range kind.CallExpr.GetArgs() {
+			inspectExpr(arg, f)
+		}
+	case *exprpb.Expr_ListExpr:
+		for _, elem := range kind.ListExpr.GetElements() {
+			inspectExpr(elem, f)
+		}
+	}
+}
+
+// maxSuggestions is the maximum number of suggestions for an unknown field.
+const maxSuggestions = 3
+
+// suggestFields returns the known fields whose name is close to the given
+// one, closest first.
+func (p *Parser) suggestFields(name string) []string {
+	type candidate struct {
+		name     string
+		distance int
+	}
+	var candidates []candidate
+	for fieldName := range p.fields {
+		distance := levenshtein(strings.ToLower(name), strings.ToLower(fieldName))
+		if distance <= maxDistance(name) {
+			candidates = append(candidates, candidate{name: fieldName, distance: distance})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].name < candidates[j].name
+	})
+	var suggestions []string
+	for i := 0; i < len(candidates) && i < maxSuggestions; i++ {
+		suggestions = append(suggestions, candidates[i].name)
+	}
+	return suggestions
+}
+
+// maxDistance returns the maximum edit distance of a suggestion, which grows
+// with the length of the name.
+func maxDistance(name string) int {
+	if n := utf8.RuneCountInString(name) / 3; n > 2 {
+		return n
+	}
+	return 2
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = prev[j-1] + cost
+			if prev[j]+1 < curr[j] {
+				curr[j] = prev[j] + 1
+			}
+			if curr[j-1]+1 < curr[j] {
+				curr[j] = curr[j-1] + 1
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
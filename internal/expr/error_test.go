@@ -0,0 +1,142 @@
+package expr
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  *Error
+	}{
+		{
+			name:  "syntax error",
+			input: "age == ",
+			want:  &Error{Kind: SyntaxErrorKind, Offset: 7, End: 7, Line: 1, Column: 8},
+		},
+		{
+			name:  "unknown field with suggestion",
+			input: "emial == 'a'",
+			want: &Error{
+				Kind: UnknownFieldErrorKind, Offset: 0, End: 5, Line: 1, Column: 1,
+				Field: "emial", Suggestions: []string{"email"},
+			},
+		},
+		{
+			name:  "unknown nested field with suggestion",
+			input: "age == 1 && company.nme == 'x'",
+			want: &Error{
+				Kind: UnknownFieldErrorKind, Offset: 12, End: 23, Line: 1, Column: 13,
+				Field: "company.nme", Suggestions: []string{"company.name"},
+			},
+		},
+		{
+			name:  "unknown field without suggestion",
+			input: "zzz == 'a'",
+			want:  &Error{Kind: UnknownFieldErrorKind, Offset: 0, End: 3, Line: 1, Column: 1, Field: "zzz"},
+		},
+		{
+			name:  "type mismatch in second line",
+			input: "age > 1 &&\n  email == 1",
+			want: &Error{
+				Kind: TypeMismatchErrorKind, Offset: 19, End: 21, Line: 2, Column: 9,
+				Field: "email", Operator: "==",
+			},
+		},
+		{
+			name:  "type mismatch of method",
+			input: "email.startsWith(1)",
+			want: &Error{
+				Kind: TypeMismatchErrorKind, Offset: 16, End: 16, Line: 1, Column: 17,
+				Field: "email", Operator: "startsWith",
+			},
+		},
+		{
+			name:  "offset in bytes after multibyte characters",
+			input: "email == 'ñandú' && age == 'x'",
+			want: &Error{
+				Kind: TypeMismatchErrorKind, Offset: 26, End: 28, Line: 1, Column: 25,
+				Field: "age", Operator: "==",
+			},
+		},
+		{
+			name:  "invalid timestamp",
+			input: "created_at > timestamp('bad')",
+			want: &Error{
+				Kind: InvalidValueErrorKind, Offset: 23, End: 28, Line: 1, Column: 24,
+				Field: "created_at", Operator: ">",
+			},
+		},
+		{
+			name:  "empty list",
+			input: "email == 'a' || age in []",
+			want: &Error{
+				Kind: InvalidValueErrorKind, Offset: 23, End: 23, Line: 1, Column: 24,
+				Field: "age", Operator: "in",
+			},
+		},
+		{
+			name:  "non boolean field as predicate",
+			input: "age",
+			want:  &Error{Kind: TypeMismatchErrorKind, Offset: 0, End: 3, Line: 1, Column: 1, Field: "age"},
+		},
+		{
+			name:  "non boolean field in logical operator",
+			input: "email == 'a' || age",
+			want: &Error{
+				Kind: TypeMismatchErrorKind, Offset: 13, End: 15, Line: 1, Column: 14,
+				Field: "age", Operator: "||",
+			},
+		},
+		{
+			name:  "depth limit",
+			input: "age == 1 && (age == 2 || (age == 3 && (age == 4 || (age == 5 && age == 6))))",
+			want:  &Error{Kind: DepthLimitErrorKind, Offset: 56, End: 56, Line: 1, Column: 57},
+		},
+	}
+
+	parser, err := NewParser(map[string]*exprpb.Type{
+		"email":        {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+		"age":          {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_INT64}},
+		"company.name": {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+		"created_at":   {TypeKind: &exprpb.Type_WellKnown{WellKnown: exprpb.Type_TIMESTAMP}},
+	})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := parser.Parse(tt.input)
+			var got *Error
+			if !errors.As(err, &got) {
+				t.Fatalf("Parse() error: %v, want *Error", err)
+			}
+			if got.Msg == "" {
+				t.Errorf("Parse() error without message")
+			}
+			got = &Error{
+				Kind:        got.Kind,
+				Offset:      got.Offset,
+				End:         got.End,
+				Line:        got.Line,
+				Column:      got.Column,
+				Field:       got.Field,
+				Operator:    got.Operator,
+				Suggestions: got.Suggestions,
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() error: %+v, want %+v", *got, *tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package expr
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Node kinds, as reported by Explain and used as JSON type discriminators.
+const (
+	KindNot     = "not"
+	KindAnd     = "and"
+	KindOr      = "or"
+	KindOp      = "op"
+	KindPresent = "present"
+	KindSize    = "size"
+	KindField   = "field"
+)
+
+// Explanation describes how an expression is translated to SQL.
+type Explanation struct {
+	Root *ExplainNode `json:"root,omitempty"`
+	SQL  string       `json:"sql"`
+	Args []any        `json:"args"`
+}
+
+// ExplainNode describes a node of an expression, tagged by its kind, and the
+// SQL fragment it's translated to. Placeholders of fragments are numbered as
+// in the whole clause.
+type ExplainNode struct {
+	Kind      string         `json:"kind"`
+	Operator  string         `json:"operator,omitempty"`
+	Field     string         `json:"field,omitempty"`
+	FieldType string         `json:"field_type,omitempty"`
+	Args      []any          `json:"args,omitempty"`
+	SQL       string         `json:"sql,omitempty"`
+	Children  []*ExplainNode `json:"children,omitempty"`
+}
+
+// Explain returns the tree of the expression along with the SQL each node is
+// translated to, accepting the same options as SQL.
+func Explain(expr *Expr, opts ...SQLOpt) (*Explanation, error) {
+	fragments := make(map[Node]string)
+	w := &sqlWriter{
+		dialect: DefaultDialect,
+		args:    []any{},
+		onWalk: func(node Node, clause string) {
+			fragments[node] = clause
+		},
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	if expr.IsZero() {
+		return &Explanation{Args: w.args}, nil
+	}
+	clause, err := w.walk(expr.Root)
+	if err != nil {
+		return nil, err
+	}
+	root, err := w.explain(expr.Root, fragments)
+	if err != nil {
+		return nil, err
+	}
+	return &Explanation{Root: root, SQL: clause, Args: w.args}, nil
+}
+
+// explain returns the explanation of the node, given the SQL fragments of
+// the nodes walked. Nodes not walked on their own, such as the fields
+// compared, are described by their column.
+func (w *sqlWriter) explain(node Node, fragments map[Node]string) (*ExplainNode, error) {
+	n := &ExplainNode{SQL: fragments[node]}
+	var children []Node
+	switch e := node.(type) {
+	case *NotExpr:
+		n.Kind = KindNot
+		n.Operator = OperatorNot
+		children = []Node{e.Not}
+	case *AndExpr:
+		n.Kind = KindAnd
+		n.Operator = OperatorAnd
+		children = []Node{e.Left, e.Right}
+	case *OrExpr:
+		n.Kind = KindOr
+		n.Operator = OperatorOr
+		children = []Node{e.Left, e.Right}
+	case *OpExpr:
+		n.Kind = KindOp
+		n.Operator = e.Op
+		n.Args = e.Args
+		field, ok := e.Left.(*Field)
+		if !ok {
+			children = []Node{e.Left}
+			break
+		}
+		// compared fields are rendered within the operation, as their column
+		column, err := w.column(field, len(e.Args))
+		if err != nil {
+			return nil, err
+		}
+		n.Children = []*ExplainNode{{
+			Kind:      KindField,
+			Field:     field.Name,
+			FieldType: field.Ftype.String(),
+			SQL:       column,
+		}}
+	case *PresentExpr:
+		n.Kind = KindPresent
+		n.Operator = OperatorPresent
+		n.Field = e.Field.Name
+		n.FieldType = e.Field.Ftype.String()
+		// negated presences are rendered as IS NULL by their negation, so
+		// they're described by the clause they'd be rendered as on their own
+		if _, ok := fragments[node]; !ok {
+			column, err := w.column(e.Field, 0)
+			if err != nil {
+				return nil, err
+			}
+			n.SQL = fmt.Sprintf("%s IS NOT NULL", column)
+		}
+	case *SizeExpr:
+		n.Kind = KindSize
+		n.Operator = OperatorSize
+		n.Field = e.Field.Name
+		n.FieldType = e.Field.Ftype.String()
+	case *Field:
+		n.Kind = KindField
+		n.Field = e.Name
+		n.FieldType = e.Ftype.String()
+	default:
+		return nil, errors.New("expr: unsupported expression")
+	}
+	for _, child := range children {
+		c, err := w.explain(child, fragments)
+		if err != nil {
+			return nil, err
+		}
+		n.Children = append(n.Children, c)
+	}
+	return n, nil
+}
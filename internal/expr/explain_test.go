@@ -0,0 +1,150 @@
+package expr
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		opts    []SQLOpt
+		want    *Explanation
+		wantErr bool
+	}{
+		{
+			name:  "empty expression",
+			input: "",
+			want:  &Explanation{Args: []any{}},
+		},
+		{
+			name:  "logical operators",
+			input: "age > 1 && (first_name == 'A' || !present(birth_date))",
+			want: &Explanation{
+				Root: &ExplainNode{
+					Kind:     KindAnd,
+					Operator: "&&",
+					SQL:      `("age" > ($1) AND (LOWER("first_name") = (LOWER($2)) OR "birth_date" IS NULL))`,
+					Children: []*ExplainNode{
+						{
+							Kind:     KindOp,
+							Operator: ">",
+							Args:     []any{int64(1)},
+							SQL:      `"age" > ($1)`,
+							Children: []*ExplainNode{
+								{Kind: KindField, Field: "age", FieldType: "integer", SQL: `"age"`},
+							},
+						},
+						{
+							Kind:     KindOr,
+							Operator: "||",
+							SQL:      `(LOWER("first_name") = (LOWER($2)) OR "birth_date" IS NULL)`,
+							Children: []*ExplainNode{
+								{
+									Kind:     KindOp,
+									Operator: "==",
+									Args:     []any{"A"},
+									SQL:      `LOWER("first_name") = (LOWER($2))`,
+									Children: []*ExplainNode{
+										{Kind: KindField, Field: "first_name", FieldType: "string", SQL: `"first_name"`},
+									},
+								},
+								{
+									Kind:     KindNot,
+									Operator: "!",
+									SQL:      `"birth_date" IS NULL`,
+									Children: []*ExplainNode{
+										{Kind: KindPresent, Operator: "present", Field: "birth_date", FieldType: "timestamp", SQL: `"birth_date" IS NOT NULL`},
+									},
+								},
+							},
+						},
+					},
+				},
+				SQL:  `("age" > ($1) AND (LOWER("first_name") = (LOWER($2)) OR "birth_date" IS NULL))`,
+				Args: []any{int64(1), "A"},
+			},
+		},
+		{
+			name:  "size and boolean field in another dialect",
+			input: "size(tags) > 2 || active",
+			opts:  []SQLOpt{WithDialect(MySQL)},
+			want: &Explanation{
+				Root: &ExplainNode{
+					Kind:     KindOr,
+					Operator: "||",
+					SQL:      "(JSON_LENGTH(`tags`) > (?) OR `active` = TRUE)",
+					Children: []*ExplainNode{
+						{
+							Kind:     KindOp,
+							Operator: ">",
+							Args:     []any{int64(2)},
+							SQL:      "JSON_LENGTH(`tags`) > (?)",
+							Children: []*ExplainNode{
+								{Kind: KindSize, Operator: "size", Field: "tags", FieldType: "string_array", SQL: "JSON_LENGTH(`tags`)"},
+							},
+						},
+						{Kind: KindField, Field: "active", FieldType: "bool", SQL: "`active` = TRUE"},
+					},
+				},
+				SQL:  "(JSON_LENGTH(`tags`) > (?) OR `active` = TRUE)",
+				Args: []any{int64(2)},
+			},
+		},
+		{
+			name:    "expression without SQL translation",
+			input:   "company.name in ['A', 'B']",
+			wantErr: true,
+		},
+	}
+
+	parser := newSQLTestParser(t)
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			expr, err := parser.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			got, err := Explain(expr, tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Explain() error: %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				gotJSON, _ := json.Marshal(got)
+				wantJSON, _ := json.Marshal(tt.want)
+				t.Errorf("Explain() got: %s, want %s", gotJSON, wantJSON)
+			}
+		})
+	}
+}
+
+func TestExplainJSON(t *testing.T) {
+	t.Parallel()
+
+	expr, err := newSQLTestParser(t).Parse("!(age in [1, 2])")
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	explanation, err := Explain(expr)
+	if err != nil {
+		t.Fatalf("Explain() error: %v", err)
+	}
+	got, err := json.Marshal(explanation)
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+	want := `{"root":{"kind":"not","operator":"!","sql":"NOT (\"age\" IN ($1,$2))","children":[` +
+		`{"kind":"op","operator":"in","args":[1,2],"sql":"\"age\" IN ($1,$2)","children":[` +
+		`{"kind":"field","field":"age","field_type":"integer","sql":"\"age\""}]}]},` +
+		`"sql":"NOT (\"age\" IN ($1,$2))","args":[1,2]}`
+	if string(got) != want {
+		t.Errorf("Marshal() got: %s, want %s", got, want)
+	}
+}
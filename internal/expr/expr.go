@@ -1,5 +1,7 @@
 package expr
 
+import "fmt"
+
 const (
 	OperatorEquals        = "=="
 	OperatorNotEquals     = "!="
@@ -11,6 +13,11 @@ const (
 	OperatorStartsWith    = "startsWith"
 	OperatorEndsWith      = "endsWith"
 	OperatorContains      = "contains"
+	OperatorNot           = "!"
+	OperatorAnd           = "&&"
+	OperatorOr            = "||"
+	OperatorPresent       = "present"
+	OperatorSize          = "size"
 )
 
 // Node defines a node in a abstract syntax tree.
@@ -27,6 +34,62 @@ func (e *Expr) IsZero() bool {
 	return e == nil || e.Root == nil
 }
 
+// Fields returns the names of the fields referenced by the expression, in
+// order of appearance and without duplicates.
+func (e *Expr) Fields() []string {
+	var names []string
+	seen := make(map[string]bool)
+	e.inspect(func(n Node) {
+		if field, ok := n.(*Field); ok && !seen[field.Name] {
+			seen[field.Name] = true
+			names = append(names, field.Name)
+		}
+	})
+	return names
+}
+
+// Operators returns the operators used by the expression, in order of
+// appearance and without duplicates.
+func (e *Expr) Operators() []string {
+	var ops []string
+	seen := make(map[string]bool)
+	e.inspect(func(n Node) {
+		var op string
+		switch n := n.(type) {
+		case *NotExpr:
+			op = OperatorNot
+		case *AndExpr:
+			op = OperatorAnd
+		case *OrExpr:
+			op = OperatorOr
+		case *OpExpr:
+			op = n.Op
+		case *PresentExpr:
+			op = OperatorPresent
+		case *SizeExpr:
+			op = OperatorSize
+		}
+		if op != "" && !seen[op] {
+			seen[op] = true
+			ops = append(ops, op)
+		}
+	})
+	return ops
+}
+
+// inspect calls f for every node of the expression, in depth first order.
+func (e *Expr) inspect(f func(Node)) {
+	if e.IsZero() {
+		return
+	}
+	Inspect(e.Root, func(n Node) bool {
+		if n != nil {
+			f(n)
+		}
+		return true
+	})
+}
+
 // NotExpr represents a NOT expression node.
 type NotExpr struct {
 	Not Node
@@ -49,6 +112,10 @@ type OpExpr struct {
 	Left Node
 	Op   string
 	Args []any
+	// CaseSensitive compares strings exactly rather than ignoring case, as
+	// conditions built by ExactEq and ExactIn do. CEL has no notation for it,
+	// so neither Format nor the protobuf representation accept it.
+	CaseSensitive bool
 }
 
 // PresentExpr represents a presence expression node.
@@ -75,8 +142,40 @@ const (
 	StringArrayFieldType
 )
 
-// Field represents a field with its name and type.
+var fieldTypeNames = map[FieldType]string{
+	BoolFieldType:        "bool",
+	IntegerFieldType:     "integer",
+	DoubleFieldType:      "double",
+	StringFieldType:      "string",
+	BytesFieldType:       "bytes",
+	TimestampFieldType:   "timestamp",
+	StringArrayFieldType: "string_array",
+}
+
+// String returns the name of the field type, as used in field set
+// definitions.
+func (t FieldType) String() string {
+	if name, ok := fieldTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("FieldType(%d)", int(t))
+}
+
+// ParseFieldType returns the field type of the given name, as returned by
+// String.
+func ParseFieldType(name string) (FieldType, bool) {
+	for t, n := range fieldTypeNames {
+		if n == name {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+// Field represents a field with its name and type, and optionally the column
+// it's mapped to.
 type Field struct {
-	Name  string
-	Ftype FieldType
+	Name   string
+	Ftype  FieldType
+	Column *Column
 }
@@ -0,0 +1,257 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Precedences of the CEL operators, from loosest to tightest.
+const (
+	precedenceOr = iota + 1
+	precedenceAnd
+	precedenceRelation
+	precedenceUnary
+	precedencePrimary
+)
+
+// Format renders the expression as canonical CEL: operators spaced, the
+// minimal parentheses, strings double quoted and timestamps in RFC 3339, in
+// UTC. Parsing the text returned yields an equal expression, so expressions
+// with case sensitive comparisons, which CEL has no notation for, can't be
+// formatted.
+func Format(expr *Expr) (string, error) {
+	if expr.IsZero() {
+		return "", nil
+	}
+	if op := caseSensitiveOp(expr.Root); op != nil {
+		return "", fmt.Errorf("expr: case sensitive %s has no CEL notation", op.Op)
+	}
+	var b strings.Builder
+	formatNode(&b, expr.Root, 0)
+	return b.String(), nil
+}
+
+// caseSensitiveOp returns the first case sensitive operation of the node, if
+// any.
+func caseSensitiveOp(node Node) *OpExpr {
+	var found *OpExpr
+	Inspect(node, func(n Node) bool {
+		if op, ok := n.(*OpExpr); ok && op.CaseSensitive && found == nil {
+			found = op
+		}
+		return found == nil
+	})
+	return found
+}
+
+// formatNode writes the node, within parentheses if it binds looser than the
+// given precedence.
+func formatNode(b *strings.Builder, node Node, precedence int) {
+	nodePrecedence := precedenceOf(node)
+	if nodePrecedence < precedence {
+		b.WriteByte('(')
+		defer b.WriteByte(')')
+	}
+	switch n := node.(type) {
+	case *NotExpr:
+		b.WriteString(OperatorNot)
+		// !! is dropped by CEL, so double negations are parenthesized
+		if _, ok := n.Not.(*NotExpr); ok {
+			formatNode(b, n.Not, precedencePrimary)
+			return
+		}
+		formatNode(b, n.Not, precedenceUnary)
+	case *AndExpr, *OrExpr:
+		op := OperatorAnd
+		if _, ok := n.(*OrExpr); ok {
+			op = OperatorOr
+		}
+		for i, term := range chainTerms(node) {
+			if i > 0 {
+				b.WriteString(" " + op + " ")
+			}
+			// chained terms of the same operator are grouped
+			formatNode(b, term, nodePrecedence+1)
+		}
+	case *OpExpr:
+		formatOp(b, n)
+	case *PresentExpr:
+		b.WriteString(OperatorPresent + "(" + n.Field.Name + ")")
+	case *SizeExpr:
+		b.WriteString(OperatorSize + "(" + n.Field.Name + ")")
+	case *Field:
+		b.WriteString(n.Name)
+	default:
+		fmt.Fprintf(b, "%v", node)
+	}
+}
+
+func precedenceOf(node Node) int {
+	switch n := node.(type) {
+	case *OrExpr:
+		return precedenceOr
+	case *AndExpr:
+		return precedenceAnd
+	case *OpExpr:
+		if isMethod(n.Op) {
+			return precedencePrimary
+		}
+		return precedenceRelation
+	case *NotExpr:
+		return precedenceUnary
+	default:
+		return precedencePrimary
+	}
+}
+
+func formatOp(b *strings.Builder, op *OpExpr) {
+	formatNode(b, op.Left, precedencePrimary)
+	switch {
+	case isMethod(op.Op):
+		b.WriteString("." + op.Op + "(")
+		formatArgs(b, op.Args)
+		b.WriteByte(')')
+	case op.Op == OperatorIn:
+		b.WriteString(" " + op.Op + " [")
+		formatArgs(b, op.Args)
+		b.WriteByte(']')
+	default:
+		b.WriteString(" " + op.Op + " ")
+		formatArgs(b, op.Args)
+	}
+}
+
+func formatArgs(b *strings.Builder, args []any) {
+	for i, arg := range args {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(formatValue(arg))
+	}
+}
+
+// formatValue returns the CEL literal of the value.
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10) + "u"
+	case float64:
+		return formatDouble(v)
+	case string:
+		return strconv.Quote(v)
+	case []byte:
+		return formatBytes(v)
+	case time.Time:
+		return "timestamp(" + strconv.Quote(v.UTC().Format(time.RFC3339Nano)) + ")"
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+// formatDouble returns the shortest CEL literal of the double, which always
+// has a fraction or an exponent, so it isn't parsed back as an integer.
+func formatDouble(v float64) string {
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return fmt.Sprintf("double(%q)", strconv.FormatFloat(v, 'g', -1, 64))
+	}
+	s := strconv.FormatFloat(v, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return s
+}
+
+// formatBytes returns the CEL literal of the bytes, escaping the non printable
+// ASCII ones.
+func formatBytes(v []byte) string {
+	var b strings.Builder
+	b.WriteString(`b"`)
+	for _, c := range v {
+		switch {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c >= 0x20 && c < 0x7f:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, `\x%02x`, c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// chainTerms returns the terms the and, or or, expression is written with.
+// CEL parses a chain of the same operator into a balanced tree, so the
+// expression is written as a chain of all its terms if it's shaped like that,
+// or as a chain of both its operands otherwise.
+func chainTerms(node Node) []Node {
+	op := OperatorAnd
+	if _, ok := node.(*OrExpr); ok {
+		op = OperatorOr
+	}
+	terms := flattenChain(node, node)
+	if sameShape(node, balancedChain(op, terms)) {
+		return terms
+	}
+	left, right, _ := chainOperands(node, node)
+	return []Node{left, right}
+}
+
+// chainOperands returns the operands of the node if it's an expression of
+// the same operator as the head of the chain.
+func chainOperands(head, node Node) (Node, Node, bool) {
+	switch n := node.(type) {
+	case *AndExpr:
+		if _, ok := head.(*AndExpr); ok {
+			return n.Left, n.Right, true
+		}
+	case *OrExpr:
+		if _, ok := head.(*OrExpr); ok {
+			return n.Left, n.Right, true
+		}
+	}
+	return nil, nil, false
+}
+
+func flattenChain(head, node Node) []Node {
+	left, right, ok := chainOperands(head, node)
+	if !ok {
+		return []Node{node}
+	}
+	return append(flattenChain(head, left), flattenChain(head, right)...)
+}
+
+// balancedChain returns the tree CEL parses the chain of terms joined by the
+// && or || operator into.
+func balancedChain(op string, terms []Node) Node {
+	if len(terms) == 1 {
+		return terms[0]
+	}
+	// the left half takes the middle term of odd chains, as in CEL
+	mid := (len(terms) + 1) / 2
+	left, right := balancedChain(op, terms[:mid]), balancedChain(op, terms[mid:])
+	if op == OperatorAnd {
+		return &AndExpr{Left: left, Right: right}
+	}
+	return &OrExpr{Left: left, Right: right}
+}
+
+// sameShape reports whether both chains have the same shape and terms.
+func sameShape(a, b Node) bool {
+	aLeft, aRight, aOk := chainOperands(a, a)
+	bLeft, bRight, bOk := chainOperands(a, b)
+	if !aOk || !bOk {
+		return !aOk && !bOk && a == b
+	}
+	return sameShape(aLeft, bLeft) && sameShape(aRight, bRight)
+}
@@ -0,0 +1,137 @@
+package expr
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "empty expression", input: "", want: ""},
+		{name: "comparison", input: "age>=18", want: "age >= 18"},
+		{name: "single quoted string", input: "first_name == 'O\\'Brien'", want: `first_name == "O'Brien"`},
+		{name: "escaped string", input: `first_name == "a\"b\né"`, want: `first_name == "a\"b\né"`},
+		{name: "raw string", input: `first_name == r'a\b'`, want: `first_name == "a\\b"`},
+		{name: "nested field", input: "company.location.zone != -1", want: "company.location.zone != -1"},
+		{name: "double", input: "price < 1.50", want: "price < 1.5"},
+		{name: "coerced integer", input: "price < 10", want: "price < 10.0"},
+		{name: "large double", input: "price < 1e21", want: "price < 1e+21"},
+		{name: "in list", input: "age in [1,2, 3]", want: "age in [1, 2, 3]"},
+		{name: "method", input: "first_name.startsWith('A')", want: `first_name.startsWith("A")`},
+		{name: "null", input: "birth_date == null", want: "birth_date == null"},
+		{name: "bool field", input: "active", want: "active"},
+		{name: "present", input: "present( birth_date )", want: "present(birth_date)"},
+		{name: "size", input: "size(tags)>1", want: "size(tags) > 1"},
+		{
+			name:  "timestamp in another time zone",
+			input: "birth_date > timestamp('2024-01-01T01:00:00.5+01:00')",
+			want:  `birth_date > timestamp("2024-01-01T00:00:00.5Z")`,
+		},
+		{
+			name:  "time arithmetic",
+			input: "birth_date > timestamp('2024-01-01T00:00:00Z') - duration('1h')",
+			want:  `birth_date > timestamp("2023-12-31T23:00:00Z")`,
+		},
+		{name: "redundant parentheses", input: "((age > 1)) && (active)", want: "age > 1 && active"},
+		{name: "and within or", input: "age > 1 && active || first_name == 'A'", want: `age > 1 && active || first_name == "A"`},
+		{name: "or within and", input: "age > 1 && (active || first_name == 'A')", want: `age > 1 && (active || first_name == "A")`},
+		{name: "chain", input: "age > 1 && active && price > 1.0 && age < 5 && !active", want: "age > 1 && active && price > 1.0 && age < 5 && !active"},
+		{name: "grouped chain", input: "(age > 1 && active) && (price > 1.0 && age < 5)", want: "age > 1 && active && price > 1.0 && age < 5"},
+		{name: "unbalanced chain", input: "((age > 1 && active) && price > 1.0) && age < 5", want: "(age > 1 && active && price > 1.0) && age < 5"},
+		{name: "right grouped chain", input: "active || (age > 1 || age < 0)", want: "active || (age > 1 || age < 0)"},
+		{name: "negated comparison", input: "!(age > 1)", want: "!(age > 1)"},
+		{name: "negated method", input: "!first_name.contains('a')", want: `!first_name.contains("a")`},
+		{name: "double negation", input: "!(!active)", want: "!(!active)"},
+		{name: "negated chain", input: "!(active || company.fortune500)", want: "!(active || company.fortune500)"},
+	}
+
+	parser := newSQLTestParser(t)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			e, err := parser.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			got, err := Format(e)
+			if err != nil {
+				t.Fatalf("Format() error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Format() got: %s, want %s", got, tt.want)
+			}
+			reparsed, err := parser.Parse(got)
+			if err != nil {
+				t.Fatalf("Parse() of formatted error: %v", err)
+			}
+			if !reflect.DeepEqual(reparsed, e) {
+				t.Errorf("Parse() of formatted got: %#v, want %#v", reparsed.Root, e.Root)
+			}
+		})
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input any
+		want  string
+	}{
+		{name: "bytes", input: []byte("a\"\\\x00\xff"), want: `b"a\"\\\x00\xff"`},
+		{name: "unsigned integer", input: uint64(1), want: "1u"},
+		{name: "small double", input: 1e-7, want: "1e-07"},
+		{name: "timestamp", input: time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC), want: `timestamp("2024-01-02T03:04:05.000000006Z")`},
+		{name: "bool", input: false, want: "false"},
+		{name: "min int", input: int64(-9223372036854775808), want: "-9223372036854775808"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := formatValue(tt.input); got != tt.want {
+				t.Errorf("formatValue() got: %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatCaseSensitive(t *testing.T) {
+	t.Parallel()
+
+	// CEL has no notation for case sensitive comparisons, which would be
+	// parsed back as case insensitive
+	parser := newSQLTestParser(t)
+	for _, cond := range []Cond{
+		ExactEq("first_name", "Foo"),
+		Or(Bool("active"), Not(ExactIn("first_name", "Foo", "Bar"))),
+	} {
+		e, err := parser.Build(cond)
+		if err != nil {
+			t.Fatalf("Build() error: %v", err)
+		}
+		if got, err := Format(e); err == nil {
+			t.Errorf("Format() got: %s, want error", got)
+		}
+	}
+}
+
+// formatted returns the expression formatted, or the error formatting it, to
+// describe expressions in test failures.
+func formatted(e *Expr) string {
+	s, err := Format(e)
+	if err != nil {
+		return err.Error()
+	}
+	return s
+}
@@ -0,0 +1,276 @@
+package expr
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Value types, used as JSON type discriminators of args.
+const (
+	ValueString    = "string"
+	ValueInt64     = "int64"
+	ValueUint64    = "uint64"
+	ValueDouble    = "double"
+	ValueBool      = "bool"
+	ValueBytes     = "bytes"
+	ValueTimestamp = "timestamp"
+	ValueNull      = "null"
+)
+
+// jsonExpr is the JSON representation of an Expr.
+type jsonExpr struct {
+	Root *jsonNode `json:"root"`
+}
+
+// jsonNode is the JSON representation of a Node, tagged by its kind.
+type jsonNode struct {
+	Type          string       `json:"type"`
+	Not           *jsonNode    `json:"not,omitempty"`
+	Left          *jsonNode    `json:"left,omitempty"`
+	Right         *jsonNode    `json:"right,omitempty"`
+	Op            string       `json:"op,omitempty"`
+	Args          []*jsonValue `json:"args,omitempty"`
+	CaseSensitive bool         `json:"case_sensitive,omitempty"`
+	Field         *jsonNode    `json:"field,omitempty"`
+	Name          string       `json:"name,omitempty"`
+	FieldType     string       `json:"field_type,omitempty"`
+	Column        *Column      `json:"column,omitempty"`
+}
+
+// jsonValue is the JSON representation of an arg, tagged by its type. Values
+// that JSON can't hold exactly are encoded as strings: int64 and uint64 in
+// base 10, timestamps as RFC 3339 and bytes in base64.
+type jsonValue struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e *Expr) MarshalJSON() ([]byte, error) {
+	var root *jsonNode
+	if !e.IsZero() {
+		var err error
+		root, err = marshalNode(e.Root)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(&jsonExpr{Root: root})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *Expr) UnmarshalJSON(data []byte) error {
+	var je jsonExpr
+	if err := json.Unmarshal(data, &je); err != nil {
+		return err
+	}
+	e.Root = nil
+	if je.Root == nil {
+		return nil
+	}
+	root, err := unmarshalNode(je.Root)
+	if err != nil {
+		return err
+	}
+	e.Root = root
+	return nil
+}
+
+func marshalNode(node Node) (*jsonNode, error) {
+	switch n := node.(type) {
+	case *NotExpr:
+		not, err := marshalNode(n.Not)
+		if err != nil {
+			return nil, err
+		}
+		return &jsonNode{Type: KindNot, Not: not}, nil
+	case *AndExpr:
+		return marshalBinary(KindAnd, n.Left, n.Right)
+	case *OrExpr:
+		return marshalBinary(KindOr, n.Left, n.Right)
+	case *OpExpr:
+		left, err := marshalNode(n.Left)
+		if err != nil {
+			return nil, err
+		}
+		args := make([]*jsonValue, len(n.Args))
+		for i, arg := range n.Args {
+			args[i], err = marshalValue(arg)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &jsonNode{Type: KindOp, Left: left, Op: n.Op, Args: args, CaseSensitive: n.CaseSensitive}, nil
+	case *PresentExpr:
+		return &jsonNode{Type: KindPresent, Field: marshalField(n.Field)}, nil
+	case *SizeExpr:
+		return &jsonNode{Type: KindSize, Field: marshalField(n.Field)}, nil
+	case *Field:
+		return marshalField(n), nil
+	default:
+		return nil, fmt.Errorf("expr: unsupported node of type %T", node)
+	}
+}
+
+func marshalBinary(kind string, l, r Node) (*jsonNode, error) {
+	left, err := marshalNode(l)
+	if err != nil {
+		return nil, err
+	}
+	right, err := marshalNode(r)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonNode{Type: kind, Left: left, Right: right}, nil
+}
+
+func marshalField(field *Field) *jsonNode {
+	return &jsonNode{
+		Type:      KindField,
+		Name:      field.Name,
+		FieldType: field.Ftype.String(),
+		Column:    field.Column,
+	}
+}
+
+func marshalValue(arg any) (*jsonValue, error) {
+	var typ string
+	var value any
+	switch v := arg.(type) {
+	case nil:
+		return &jsonValue{Type: ValueNull}, nil
+	case string:
+		typ, value = ValueString, v
+	case int64:
+		typ, value = ValueInt64, strconv.FormatInt(v, 10)
+	case uint64:
+		typ, value = ValueUint64, strconv.FormatUint(v, 10)
+	case float64:
+		typ, value = ValueDouble, v
+	case bool:
+		typ, value = ValueBool, v
+	case []byte:
+		typ, value = ValueBytes, base64.StdEncoding.EncodeToString(v)
+	case time.Time:
+		typ, value = ValueTimestamp, v.Format(time.RFC3339Nano)
+	default:
+		return nil, fmt.Errorf("expr: unsupported arg of type %T", arg)
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonValue{Type: typ, Value: raw}, nil
+}
+
+func unmarshalNode(jn *jsonNode) (Node, error) {
+	switch jn.Type {
+	case KindNot:
+		if jn.Not == nil {
+			return nil, fmt.Errorf("expr: missing operand of %s node", jn.Type)
+		}
+		not, err := unmarshalNode(jn.Not)
+		if err != nil {
+			return nil, err
+		}
+		return &NotExpr{Not: not}, nil
+	case KindAnd, KindOr:
+		if jn.Left == nil || jn.Right == nil {
+			return nil, fmt.Errorf("expr: missing operand of %s node", jn.Type)
+		}
+		left, err := unmarshalNode(jn.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := unmarshalNode(jn.Right)
+		if err != nil {
+			return nil, err
+		}
+		if jn.Type == KindAnd {
+			return &AndExpr{Left: left, Right: right}, nil
+		}
+		return &OrExpr{Left: left, Right: right}, nil
+	case KindOp:
+		if jn.Left == nil {
+			return nil, fmt.Errorf("expr: missing operand of %s node", jn.Type)
+		}
+		left, err := unmarshalNode(jn.Left)
+		if err != nil {
+			return nil, err
+		}
+		var args []any
+		for _, jv := range jn.Args {
+			arg, err := unmarshalValue(jv)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+		}
+		return &OpExpr{Left: left, Op: jn.Op, Args: args, CaseSensitive: jn.CaseSensitive}, nil
+	case KindPresent, KindSize:
+		if jn.Field == nil {
+			return nil, fmt.Errorf("expr: missing field of %s node", jn.Type)
+		}
+		field, err := unmarshalField(jn.Field)
+		if err != nil {
+			return nil, err
+		}
+		if jn.Type == KindPresent {
+			return &PresentExpr{Field: field}, nil
+		}
+		return &SizeExpr{Field: field}, nil
+	case KindField:
+		return unmarshalField(jn)
+	default:
+		return nil, fmt.Errorf("expr: unknown node type %q", jn.Type)
+	}
+}
+
+func unmarshalField(jn *jsonNode) (*Field, error) {
+	if jn.Type != KindField {
+		return nil, fmt.Errorf("expr: unexpected %s node, want a field", jn.Type)
+	}
+	ftype, ok := ParseFieldType(jn.FieldType)
+	if !ok {
+		return nil, fmt.Errorf("expr: unknown field type %q", jn.FieldType)
+	}
+	return &Field{Name: jn.Name, Ftype: ftype, Column: jn.Column}, nil
+}
+
+func unmarshalValue(jv *jsonValue) (any, error) {
+	if jv.Type == ValueNull {
+		return nil, nil
+	}
+	var s string
+	switch jv.Type {
+	case ValueDouble:
+		var v float64
+		err := json.Unmarshal(jv.Value, &v)
+		return v, err
+	case ValueBool:
+		var v bool
+		err := json.Unmarshal(jv.Value, &v)
+		return v, err
+	case ValueString, ValueInt64, ValueUint64, ValueBytes, ValueTimestamp:
+		if err := json.Unmarshal(jv.Value, &s); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("expr: unknown value type %q", jv.Type)
+	}
+	switch jv.Type {
+	case ValueInt64:
+		return strconv.ParseInt(s, 10, 64)
+	case ValueUint64:
+		return strconv.ParseUint(s, 10, 64)
+	case ValueBytes:
+		return base64.StdEncoding.DecodeString(s)
+	case ValueTimestamp:
+		return time.Parse(time.RFC3339Nano, s)
+	default:
+		return s, nil
+	}
+}
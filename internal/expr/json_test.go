@@ -0,0 +1,129 @@
+package expr
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestExprJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "empty expression", input: ""},
+		{name: "logical operators", input: "age > 1 && (first_name == 'A' || !present(birth_date))"},
+		{name: "in list", input: "company.location.zone in [1, 2, 3] || price in [1.5, 2.0]"},
+		{name: "methods", input: "first_name.startsWith('A') && tags.contains('b')"},
+		{name: "size", input: "size(tags) >= 2"},
+		{name: "bool field", input: "active && !company.fortune500"},
+		{name: "null", input: "first_name != null"},
+		{name: "large integers", input: "age == 9223372036854775807 || age == -9223372036854775808"},
+		{name: "timestamps", input: "birth_date > timestamp('2024-01-01T10:00:00.123456789Z')"},
+	}
+
+	parser := newSQLTestParser(t)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			want, err := parser.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			data, err := json.Marshal(want)
+			if err != nil {
+				t.Fatalf("json.Marshal() error: %v", err)
+			}
+			got := new(Expr)
+			if err := json.Unmarshal(data, got); err != nil {
+				t.Fatalf("json.Unmarshal() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("json round trip got: %#v, want %#v", got.Root, want.Root)
+			}
+		})
+	}
+}
+
+func TestExprJSONArgs(t *testing.T) {
+	t.Parallel()
+
+	field := &Field{Name: "data", Ftype: BytesFieldType, Column: &Column{Name: []string{"t", "data"}, Path: []string{"a"}}}
+	want := &Expr{Root: &OpExpr{
+		Left: field,
+		Op:   OperatorIn,
+		Args: []any{
+			nil,
+			"a",
+			int64(-1),
+			uint64(18446744073709551615),
+			0.1,
+			true,
+			[]byte{0, 1, 0xff},
+			time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC),
+			time.Date(2024, 1, 2, 3, 4, 5, 6, time.FixedZone("", 3600)),
+		},
+	}}
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("json.Marshal() error: %v", err)
+	}
+	got := new(Expr)
+	if err := json.Unmarshal(data, got); err != nil {
+		t.Fatalf("json.Unmarshal() error: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("json round trip got: %#v, want %#v", got.Root, want.Root)
+	}
+}
+
+func TestExprJSONFormat(t *testing.T) {
+	t.Parallel()
+
+	parser := newSQLTestParser(t)
+	e, err := parser.Parse("!(age in [1, 2]) || present(birth_date)")
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	got, err := json.Marshal(e)
+	if err != nil {
+		t.Fatalf("json.Marshal() error: %v", err)
+	}
+	want := `{"root":{"type":"or",` +
+		`"left":{"type":"not","not":{"type":"op","left":{"type":"field","name":"age","field_type":"integer"},"op":"in",` +
+		`"args":[{"type":"int64","value":"1"},{"type":"int64","value":"2"}]}},` +
+		`"right":{"type":"present","field":{"type":"field","name":"birth_date","field_type":"timestamp"}}}}`
+	if string(got) != want {
+		t.Errorf("json.Marshal() got: %s, want %s", got, want)
+	}
+}
+
+func TestExprUnmarshalJSONError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "unknown node type", input: `{"root":{"type":"xor"}}`},
+		{name: "missing operand", input: `{"root":{"type":"and","left":{"type":"field","name":"a","field_type":"bool"}}}`},
+		{name: "unknown field type", input: `{"root":{"type":"field","name":"a","field_type":"uuid"}}`},
+		{name: "present of a non field", input: `{"root":{"type":"present","field":{"type":"not"}}}`},
+		{name: "unknown value type", input: `{"root":{"type":"op","left":{"type":"field","name":"a","field_type":"integer"},"op":"==","args":[{"type":"int32","value":"1"}]}}`},
+		{name: "invalid value", input: `{"root":{"type":"op","left":{"type":"field","name":"a","field_type":"integer"},"op":"==","args":[{"type":"int64","value":"a"}]}}`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := json.Unmarshal([]byte(tt.input), new(Expr)); err == nil {
+				t.Errorf("json.Unmarshal() error: nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package expr
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/operators"
+	"github.com/google/cel-go/common/overloads"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+// WarningKind classifies lint warnings.
+type WarningKind int
+
+// Warning kinds.
+const (
+	// EmptyPatternWarningKind reports a startsWith, endsWith or contains
+	// with an empty argument, which matches any non null value.
+	EmptyPatternWarningKind WarningKind = iota
+	// DuplicateValueWarningKind reports a value repeated in an in list.
+	DuplicateValueWarningKind
+)
+
+var warningKindNames = map[WarningKind]string{
+	EmptyPatternWarningKind:   "empty_pattern",
+	DuplicateValueWarningKind: "duplicate_value",
+}
+
+// String returns the name of the warning kind.
+func (k WarningKind) String() string {
+	if name, ok := warningKindNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("WarningKind(%d)", int(k))
+}
+
+// Warning is a valid, yet likely unintended, construct of an expression. Its
+// position is reported as the one of Error.
+type Warning struct {
+	Kind     WarningKind
+	Msg      string
+	Offset   int
+	End      int
+	Line     int
+	Column   int
+	Field    string
+	Operator string
+}
+
+// Lint parses the filter as Parse does, also returning the warnings found in
+// it.
+func (p *Parser) Lint(filter string) (*Expr, []*Warning, error) {
+	if filter == "" {
+		return &Expr{}, nil, nil
+	}
+
+	ast, err := p.compile(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	e, err := p.check(ast)
+	if err != nil {
+		err.locate(filter, ast.SourceInfo())
+		return nil, nil, err
+	}
+	return e, p.warnings(filter, ast), nil
+}
+
+// warnings returns the warnings of the checked ast, in order of appearance.
+func (p *Parser) warnings(filter string, ast *cel.Ast) []*Warning {
+	positions := ast.SourceInfo().GetPositions()
+	var warnings []*Warning
+	warn := func(kind WarningKind, expr *exprpb.Expr, field, op, msg string) {
+		w := &Warning{Kind: kind, Msg: msg, Field: field, Operator: op}
+		w.Offset, w.Line, w.Column = position(filter, int(positions[expr.GetId()]))
+		w.End = tokenEnd(filter, w.Offset)
+		warnings = append(warnings, w)
+	}
+
+	inspectExpr(ast.Expr(), func(expr *exprpb.Expr) {
+		callExpr, ok := expr.GetExprKind().(*exprpb.Expr_CallExpr)
+		if !ok {
+			return
+		}
+		switch callExpr.CallExpr.GetFunction() {
+		case overloads.StartsWith, overloads.EndsWith, overloads.Contains:
+			arg := callExpr.CallExpr.GetArgs()[0]
+			if v, err := p.value(arg); err == nil && v == "" {
+				op := callExpr.CallExpr.GetFunction()
+				warn(EmptyPatternWarningKind, arg, qualifiedName(callExpr.CallExpr.GetTarget()), op,
+					fmt.Sprintf("%s with an empty string matches any value", op))
+			}
+		case operators.In:
+			field := qualifiedName(callExpr.CallExpr.GetArgs()[0])
+			var values []any
+			for _, elem := range callExpr.CallExpr.GetArgs()[1].GetListExpr().GetElements() {
+				v, err := p.value(elem)
+				if err != nil {
+					continue
+				}
+				if containsValue(values, v) {
+					warn(DuplicateValueWarningKind, elem, field, OperatorIn,
+						fmt.Sprintf("duplicated value %v in list", v))
+					continue
+				}
+				values = append(values, v)
+			}
+		}
+	})
+	return warnings
+}
+
+// containsValue reports whether values holds v.
+func containsValue(values []any, v any) bool {
+	for _, value := range values {
+		if t, ok := v.(time.Time); ok {
+			if u, ok := value.(time.Time); ok && t.Equal(u) {
+				return true
+			}
+			continue
+		}
+		if reflect.DeepEqual(value, v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package expr
+
+import (
+	"reflect"
+	"testing"
+
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    []*Warning
+		wantErr bool
+	}{
+		{
+			name:  "no warnings",
+			input: "email.startsWith('a') && age in [1, 2]",
+		},
+		{
+			name:  "empty pattern",
+			input: "age > 1 && email.contains('')",
+			want: []*Warning{{
+				Kind: EmptyPatternWarningKind, Offset: 26, End: 28, Line: 1, Column: 27,
+				Field: "email", Operator: "contains",
+			}},
+		},
+		{
+			name:  "duplicate values",
+			input: "age in [1, 2, 1] || email in [\"a\", 'a']",
+			want: []*Warning{
+				{
+					Kind: DuplicateValueWarningKind, Offset: 14, End: 15, Line: 1, Column: 15,
+					Field: "age", Operator: "in",
+				},
+				{
+					Kind: DuplicateValueWarningKind, Offset: 35, End: 38, Line: 1, Column: 36,
+					Field: "email", Operator: "in",
+				},
+			},
+		},
+		{
+			name:  "duplicate timestamps in different time zones",
+			input: "created_at in [timestamp('2024-01-01T00:00:00Z'), timestamp('2024-01-01T01:00:00+01:00')]",
+			want: []*Warning{{
+				Kind: DuplicateValueWarningKind, Offset: 59, End: 59, Line: 1, Column: 60,
+				Field: "created_at", Operator: "in",
+			}},
+		},
+		{
+			name:    "invalid expression",
+			input:   "age == 'a'",
+			wantErr: true,
+		},
+	}
+
+	parser, err := NewParser(map[string]*exprpb.Type{
+		"email":      {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+		"age":        {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_INT64}},
+		"created_at": {TypeKind: &exprpb.Type_WellKnown{WellKnown: exprpb.Type_TIMESTAMP}},
+	})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, got, err := parser.Lint(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Lint() error: %v, wantErr %v", err, tt.wantErr)
+			}
+			for _, w := range got {
+				if w.Msg == "" {
+					t.Errorf("Lint() warning without message")
+				}
+				w.Msg = ""
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lint() got: %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
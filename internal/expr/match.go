@@ -0,0 +1,530 @@
+package expr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// truth is a value of the three-valued logic of SQL, ordered so that AND
+// takes the least of its operands and OR the greatest.
+type truth int8
+
+const (
+	truthFalse truth = iota
+	truthUnknown
+	truthTrue
+)
+
+func truthOf(b bool) truth {
+	if b {
+		return truthTrue
+	}
+	return truthFalse
+}
+
+// Match reports whether the record matches the expression, as a row with the
+// same values would match its SQL clause in the default dialect: missing and
+// null values are NULL, so comparisons against them are neither true nor
+// false, strings are compared ignoring case, except the elements of string
+// arrays and by case sensitive operations, and the size of a missing array is
+// 0. An empty expression matches every record.
+//
+// Records are maps with string keys, structs, or pointers to them, and
+// protobuf messages. Fields are looked up by name, dotted names such as
+// "company.name" being looked up within nested records. Struct fields are
+// named by their filter tag, their json tag or else their own name, and
+// protobuf fields by their name or JSON name. Values are converted to the
+// type of their field, so JSON numbers and RFC 3339 strings are accepted as
+// integers and timestamps.
+func Match(expr *Expr, record any) (bool, error) {
+	if expr.IsZero() {
+		return true, nil
+	}
+	m := &matcher{record: record}
+	t, err := m.eval(expr.Root)
+	if err != nil {
+		return false, err
+	}
+	return t == truthTrue, nil
+}
+
+// matcher evaluates nodes against a record.
+type matcher struct {
+	record any
+}
+
+func (m *matcher) eval(node Node) (truth, error) {
+	switch e := node.(type) {
+	case *NotExpr:
+		t, err := m.eval(e.Not)
+		if err != nil {
+			return 0, err
+		}
+		return truthTrue - t, nil
+	case *AndExpr:
+		l, r, err := m.evalOperands(e.Left, e.Right)
+		if err != nil {
+			return 0, err
+		}
+		if l < r {
+			return l, nil
+		}
+		return r, nil
+	case *OrExpr:
+		l, r, err := m.evalOperands(e.Left, e.Right)
+		if err != nil {
+			return 0, err
+		}
+		if l > r {
+			return l, nil
+		}
+		return r, nil
+	case *OpExpr:
+		return m.evalOp(e)
+	case *Field:
+		if e.Ftype != BoolFieldType {
+			return 0, fmt.Errorf("expr: unsupported non boolean field %s as predicate", e.Name)
+		}
+		value, err := m.value(e)
+		if err != nil || value == nil {
+			return truthUnknown, err
+		}
+		return truthOf(value.(bool)), nil
+	case *PresentExpr:
+		value, err := m.value(e.Field)
+		if err != nil {
+			return 0, err
+		}
+		return truthOf(value != nil), nil
+	default:
+		return 0, errors.New("expr: unsupported expression")
+	}
+}
+
+// evalOperands evaluates both operands, without short-circuiting, so errors
+// are reported as when running the SQL clause.
+func (m *matcher) evalOperands(left, right Node) (truth, truth, error) {
+	l, err := m.eval(left)
+	if err != nil {
+		return 0, 0, err
+	}
+	r, err := m.eval(right)
+	if err != nil {
+		return 0, 0, err
+	}
+	return l, r, nil
+}
+
+func (m *matcher) evalOp(e *OpExpr) (truth, error) {
+	var value any
+	var ftype FieldType
+	switch left := e.Left.(type) {
+	case *Field:
+		var err error
+		if value, err = m.value(left); err != nil {
+			return 0, err
+		}
+		ftype = left.Ftype
+	case *SizeExpr:
+		elems, err := m.value(left.Field)
+		if err != nil {
+			return 0, err
+		}
+		list, ok := elems.([]string)
+		if elems != nil && !ok {
+			return 0, fmt.Errorf("expr: unsupported size of field %s of type %s", left.Field.Name, left.Field.Ftype)
+		}
+		value, ftype = int64(len(list)), IntegerFieldType
+	default:
+		return 0, errors.New("expr: unsupported operation expression")
+	}
+
+	// comparisons against null are IS NULL and IS NOT NULL, while any other
+	// comparison of null is unknown
+	if isNullComparison(e) {
+		return truthOf((value == nil) == (e.Op == OperatorEquals)), nil
+	}
+	if _, ok := sqlOperatorLookup[e.Op][ftype]; !ok {
+		return 0, errors.New("expr: unsupported operation expression")
+	}
+	if value == nil {
+		return truthUnknown, nil
+	}
+
+	// args of string arrays are their elements
+	argType := ftype
+	if ftype == StringArrayFieldType {
+		argType = StringFieldType
+	}
+	args := make([]any, len(e.Args))
+	for i, arg := range e.Args {
+		var err error
+		if args[i], err = convert(arg, argType); err != nil {
+			return 0, err
+		}
+		if args[i] == nil {
+			return truthUnknown, nil
+		}
+	}
+	if len(args) != 1 && e.Op != OperatorIn {
+		return 0, fmt.Errorf("expr: invalid number of arguments of %s", e.Op)
+	}
+
+	switch e.Op {
+	case OperatorStartsWith, OperatorEndsWith, OperatorContains:
+		if ftype == StringArrayFieldType {
+			for _, elem := range value.([]string) {
+				if elem == args[0] {
+					return truthTrue, nil
+				}
+			}
+			return truthFalse, nil
+		}
+		s, pattern := strings.ToLower(value.(string)), strings.ToLower(args[0].(string))
+		switch e.Op {
+		case OperatorStartsWith:
+			return truthOf(strings.HasPrefix(s, pattern)), nil
+		case OperatorEndsWith:
+			return truthOf(strings.HasSuffix(s, pattern)), nil
+		default:
+			return truthOf(strings.Contains(s, pattern)), nil
+		}
+	case OperatorIn:
+		for _, arg := range args {
+			if compareValues(value, arg, e.CaseSensitive) == 0 {
+				return truthTrue, nil
+			}
+		}
+		return truthFalse, nil
+	}
+
+	c := compareValues(value, args[0], e.CaseSensitive)
+	switch e.Op {
+	case OperatorEquals:
+		return truthOf(c == 0), nil
+	case OperatorNotEquals:
+		return truthOf(c != 0), nil
+	case OperatorLess:
+		return truthOf(c < 0), nil
+	case OperatorLessEquals:
+		return truthOf(c <= 0), nil
+	case OperatorGreater:
+		return truthOf(c > 0), nil
+	default:
+		return truthOf(c >= 0), nil
+	}
+}
+
+// compareValues compares values converted to the same field type, strings
+// ignoring case unless caseSensitive.
+func compareValues(a, b any, caseSensitive bool) int {
+	switch a := a.(type) {
+	case bool:
+		if a == b.(bool) {
+			return 0
+		}
+		return 1
+	case int64:
+		return compareOrdered(a, b.(int64))
+	case float64:
+		return compareOrdered(a, b.(float64))
+	case string:
+		if caseSensitive {
+			return strings.Compare(a, b.(string))
+		}
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b.(string)))
+	case time.Time:
+		switch b := b.(time.Time); {
+		case a.Before(b):
+			return -1
+		case a.After(b):
+			return 1
+		default:
+			return 0
+		}
+	default:
+		return 1
+	}
+}
+
+func compareOrdered[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// value returns the value of the field in the record, converted to the field
+// type, or nil if missing.
+func (m *matcher) value(field *Field) (any, error) {
+	raw, err := lookup(m.record, strings.Split(field.Name, "."))
+	if err != nil {
+		return nil, fmt.Errorf("expr: field %s: %w", field.Name, err)
+	}
+	value, err := convert(raw, field.Ftype)
+	if err != nil {
+		return nil, fmt.Errorf("expr: field %s: %w", field.Name, err)
+	}
+	return value, nil
+}
+
+// lookup returns the value found at the path within the record, or nil if
+// missing.
+func lookup(record any, path []string) (any, error) {
+	if len(path) == 0 || record == nil {
+		return record, nil
+	}
+	if msg, ok := record.(proto.Message); ok {
+		return lookupMessage(msg, path)
+	}
+	v := reflect.ValueOf(record)
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported map of type %s", v.Type())
+		}
+		// dotted keys are looked up as is first
+		for i := len(path); i > 0; i-- {
+			key := reflect.ValueOf(strings.Join(path[:i], ".")).Convert(v.Type().Key())
+			if elem := v.MapIndex(key); elem.IsValid() {
+				return lookup(elem.Interface(), path[i:])
+			}
+		}
+		return nil, nil
+	case reflect.Struct:
+		elem, ok := structField(v, path[0])
+		if !ok {
+			return nil, nil
+		}
+		return lookup(elem.Interface(), path[1:])
+	default:
+		return nil, nil
+	}
+}
+
+// structField returns the field of the struct with the given name, from its
+// filter tag, its json tag or else its own name, ignoring case as
+// encoding/json does, looking into embedded structs.
+func structField(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	var embedded []int
+	folded := -1
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		// exported fields of embedded structs are promoted, even if the
+		// struct isn't
+		if !f.IsExported() && !f.Anonymous {
+			continue
+		}
+		fieldName, tagged := f.Name, false
+		for _, key := range []string{"filter", "json"} {
+			if tag, ok := f.Tag.Lookup(key); ok {
+				fieldName, _, _ = strings.Cut(tag, ",")
+				tagged = fieldName != ""
+				break
+			}
+		}
+		if fieldName == "" {
+			fieldName = f.Name
+		}
+		switch {
+		case fieldName == "-":
+		case f.Anonymous && !tagged:
+			embedded = append(embedded, i)
+		case !f.IsExported():
+		case fieldName == name:
+			return v.Field(i), true
+		case !tagged && folded < 0 && strings.EqualFold(fieldName, name):
+			folded = i
+		}
+	}
+	if folded >= 0 {
+		return v.Field(folded), true
+	}
+	for _, i := range embedded {
+		elem := v.Field(i)
+		if elem.Kind() == reflect.Pointer {
+			if elem.IsNil() {
+				continue
+			}
+			elem = elem.Elem()
+		}
+		if elem.Kind() != reflect.Struct {
+			continue
+		}
+		if field, ok := structField(elem, name); ok {
+			return field, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// lookupMessage returns the value found at the path within the protobuf
+// message, or nil if missing.
+func lookupMessage(msg proto.Message, path []string) (any, error) {
+	if s, ok := msg.(*structpb.Struct); ok {
+		return lookup(s.AsMap(), path)
+	}
+	m := msg.ProtoReflect()
+	if !m.IsValid() {
+		return nil, nil
+	}
+	fields := m.Descriptor().Fields()
+	fd := fields.ByName(protoreflect.Name(path[0]))
+	if fd == nil {
+		fd = fields.ByJSONName(path[0])
+	}
+	if fd == nil || fd.HasPresence() && !m.Has(fd) {
+		return nil, nil
+	}
+	return lookup(messageValue(fd, m.Get(fd)), path[1:])
+}
+
+// messageValue returns the Go value of a protobuf field: lists as slices,
+// maps as maps, enums as numbers and well known types as their Go
+// equivalent.
+func messageValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) any {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		elems := make([]any, list.Len())
+		for i := range elems {
+			elems[i] = scalarValue(fd, list.Get(i))
+		}
+		return elems
+	case fd.IsMap():
+		entries := make(map[string]any)
+		v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			entries[k.String()] = scalarValue(fd.MapValue(), v)
+			return true
+		})
+		return entries
+	default:
+		return scalarValue(fd, v)
+	}
+}
+
+func scalarValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) any {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		return int64(v.Enum())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		switch msg := v.Message().Interface().(type) {
+		case *timestamppb.Timestamp:
+			return msg.AsTime()
+		case *structpb.Value:
+			return msg.AsInterface()
+		case *structpb.ListValue:
+			return msg.AsSlice()
+		}
+		// wrappers hold their value in their only field
+		if desc := fd.Message(); desc.FullName().Parent() == "google.protobuf" && strings.HasSuffix(string(desc.Name()), "Value") {
+			return v.Message().Get(desc.Fields().ByNumber(1)).Interface()
+		}
+		return v.Message().Interface()
+	default:
+		return v.Interface()
+	}
+}
+
+// convert converts the value to the type of the args of fields of the given
+// type, or nil if null.
+func convert(value any, ftype FieldType) (any, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case time.Time:
+		if ftype == TimestampFieldType {
+			return v, nil
+		}
+	case *timestamppb.Timestamp:
+		if ftype == TimestampFieldType {
+			return v.AsTime(), nil
+		}
+	case json.Number:
+		switch ftype {
+		case IntegerFieldType:
+			return v.Int64()
+		case DoubleFieldType:
+			return v.Float64()
+		}
+	}
+
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil, nil
+		}
+		rv = rv.Elem()
+	}
+	switch {
+	case ftype == BoolFieldType && rv.Kind() == reflect.Bool:
+		return rv.Bool(), nil
+	case ftype == IntegerFieldType && rv.CanInt():
+		return rv.Int(), nil
+	case ftype == IntegerFieldType && rv.CanUint():
+		if rv.Uint() > math.MaxInt64 {
+			return nil, fmt.Errorf("value %d overflows int64", rv.Uint())
+		}
+		return int64(rv.Uint()), nil
+	case ftype == IntegerFieldType && rv.CanFloat():
+		// JSON numbers are decoded as doubles
+		f := rv.Float()
+		if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+			return nil, fmt.Errorf("value %v isn't an integer", f)
+		}
+		return int64(f), nil
+	case ftype == DoubleFieldType && rv.CanFloat():
+		return rv.Float(), nil
+	case ftype == DoubleFieldType && rv.CanInt():
+		return float64(rv.Int()), nil
+	case ftype == DoubleFieldType && rv.CanUint():
+		return float64(rv.Uint()), nil
+	case ftype == StringFieldType && rv.Kind() == reflect.String:
+		return rv.String(), nil
+	case ftype == BytesFieldType && rv.Kind() == reflect.String:
+		return []byte(rv.String()), nil
+	case ftype == BytesFieldType && rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8:
+		return rv.Bytes(), nil
+	case ftype == TimestampFieldType && rv.Kind() == reflect.String:
+		t, err := time.Parse(time.RFC3339, rv.String())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse time: %w", err)
+		}
+		return t, nil
+	case ftype == StringArrayFieldType && (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array):
+		elems := make([]string, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			elem, err := convert(rv.Index(i).Interface(), StringFieldType)
+			if err != nil {
+				return nil, err
+			}
+			// null elements are never contained
+			if elem != nil {
+				elems = append(elems, elem.(string))
+			}
+		}
+		return elems, nil
+	}
+	return nil, fmt.Errorf("unsupported value of type %T for a field of type %s", value, ftype)
+}
@@ -0,0 +1,185 @@
+package expr
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	birthDate := time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC)
+	age := uint8(35)
+	record := map[string]any{
+		"first_name": "Paco",
+		"age":        35,
+		"price":      9.5,
+		"active":     true,
+		"birth_date": birthDate,
+		"tags":       []string{"Admin", "dev"},
+		"company": map[string]any{
+			"name":     "ACME_Corp",
+			"location": map[string]any{"zone": int64(3)},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		record  any
+		want    bool
+		wantErr bool
+	}{
+		{name: "empty expression", input: "", record: record, want: true},
+		{name: "equality ignoring case", input: "first_name == 'PACO'", record: record, want: true},
+		{name: "inequality ignoring case", input: "first_name != 'paco'", record: record, want: false},
+		{name: "in list ignoring case", input: "first_name in ['a', 'pAcO']", record: record, want: true},
+		{name: "integer comparison", input: "age >= 35 && age < 36", record: record, want: true},
+		{name: "integer in list", input: "age in [1, 2]", record: record, want: false},
+		{name: "double comparison", input: "price > 9", record: record, want: true},
+		{name: "bool field", input: "active", record: record, want: true},
+		{name: "bool equality", input: "active == false", record: record, want: false},
+		{name: "timestamp comparison", input: "birth_date < timestamp('1990-05-17T00:00:01Z')", record: record, want: true},
+		{name: "timestamp equality", input: "birth_date == timestamp('1990-05-17T02:00:00+02:00')", record: record, want: true},
+		{name: "starts with ignoring case", input: "first_name.startsWith('pa')", record: record, want: true},
+		{name: "ends with ignoring case", input: "first_name.endsWith('CO')", record: record, want: true},
+		{name: "contains wildcards literally", input: "company.name.contains('e_c')", record: record, want: true},
+		{name: "contains wildcard not matching", input: "company.name.contains('%')", record: record, want: false},
+		{name: "array contains", input: "tags.contains('dev')", record: record, want: true},
+		{name: "array contains is case sensitive", input: "tags.contains('admin')", record: record, want: false},
+		{name: "size", input: "size(tags) == 2", record: record, want: true},
+		{name: "size of missing array", input: "size(tags) == 0", record: map[string]any{}, want: true},
+		{name: "nested field", input: "company.location.zone == 3", record: record, want: true},
+		{name: "dotted key", input: "company.location.zone == 3", record: map[string]any{"company.location": map[string]any{"zone": 3}}, want: true},
+		{name: "present", input: "present(birth_date) && !present(company.fortune500)", record: record, want: true},
+		{name: "null equality", input: "company.fortune500 == null", record: record, want: true},
+		{name: "null inequality", input: "first_name != null", record: record, want: true},
+		{name: "missing field is unknown", input: "age > 1", record: map[string]any{}, want: false},
+		{name: "negated unknown", input: "!(age > 1)", record: map[string]any{}, want: false},
+		{name: "unknown and false", input: "!(age > 1 && active)", record: map[string]any{"active": false}, want: true},
+		{name: "unknown or true", input: "age > 1 || active", record: map[string]any{"active": true}, want: true},
+		{name: "nil value is unknown", input: "!(first_name == 'a')", record: map[string]any{"first_name": nil}, want: false},
+		{name: "json numbers", input: "age == 35 && price == 9.5", record: map[string]any{"age": 35.0, "price": json.Number("9.5")}, want: true},
+		{name: "json timestamps", input: "birth_date == timestamp('1990-05-17T00:00:00Z')", record: map[string]any{"birth_date": "1990-05-17T00:00:00Z"}, want: true},
+		{name: "json arrays", input: "tags.contains('a')", record: map[string]any{"tags": []any{nil, "a"}}, want: true},
+		{name: "protobuf timestamps", input: "birth_date == timestamp('1990-05-17T00:00:00Z')", record: map[string]any{"birth_date": timestamppb.New(birthDate)}, want: true},
+		{
+			name:  "struct",
+			input: "first_name == 'paco' && age == 35 && company.name == 'acme' && active",
+			record: &struct {
+				FirstName string `json:"first_name"`
+				Age       *uint8 `filter:"age" json:"years"`
+				Company   struct {
+					Name string
+				} `json:"company"`
+				flags
+			}{FirstName: "Paco", Age: &age, Company: struct{ Name string }{Name: "ACME"}, flags: flags{Active: true}},
+			want: true,
+		},
+		{
+			name:  "struct nil pointer",
+			input: "present(age)",
+			record: struct {
+				Age *int `json:"age"`
+			}{},
+			want: false,
+		},
+		{
+			name:  "protobuf struct",
+			input: "first_name == 'paco' && company.location.zone == 3 && tags.contains('a')",
+			record: func() any {
+				s, _ := structpb.NewStruct(map[string]any{
+					"first_name": "Paco",
+					"company":    map[string]any{"location": map[string]any{"zone": 3}},
+					"tags":       []any{"a"},
+				})
+				return s
+			}(),
+			want: true,
+		},
+		{name: "invalid value", input: "age == 1", record: map[string]any{"age": "1"}, wantErr: true},
+		{name: "non integer value", input: "age == 1", record: map[string]any{"age": 1.5}, wantErr: true},
+		{name: "invalid timestamp", input: "present(birth_date)", record: map[string]any{"birth_date": "yesterday"}, wantErr: true},
+		{name: "invalid map", input: "age == 1", record: map[int]any{1: 1}, wantErr: true},
+	}
+
+	parser := newSQLTestParser(t)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			e, err := parser.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			got, err := Match(e, tt.record)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Match() error: %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Match() got: %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type flags struct {
+	Active bool `json:"active"`
+}
+
+func TestMatchMessage(t *testing.T) {
+	t.Parallel()
+
+	parser, err := NewParser(map[string]*exprpb.Type{
+		"name":               {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+		"number":             {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_INT64}},
+		"label":              {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_INT64}},
+		"jsonName":           {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+		"options.deprecated": {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_BOOL}},
+	})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	record := &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String("Paco"),
+		Number:   proto.Int32(3),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+		JsonName: proto.String("paco"),
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{name: "field by name", input: "name == 'paco' && number == 3", want: true},
+		{name: "field by JSON name", input: "jsonName == 'PACO'", want: true},
+		{name: "enum", input: "label == 3", want: true},
+		{name: "unset field", input: "present(options.deprecated) || options.deprecated == false", want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			e, err := parser.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			got, err := Match(e, record)
+			if err != nil {
+				t.Fatalf("Match() error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Match() got: %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
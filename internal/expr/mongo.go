@@ -0,0 +1,453 @@
+package expr
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MongoDB query operators.
+const (
+	mongoEq     = "$eq"
+	mongoNe     = "$ne"
+	mongoGt     = "$gt"
+	mongoGte    = "$gte"
+	mongoLt     = "$lt"
+	mongoLte    = "$lte"
+	mongoIn     = "$in"
+	mongoNin    = "$nin"
+	mongoExists = "$exists"
+	mongoRegex  = "$regex"
+	mongoOpts   = "$options"
+	mongoAnd    = "$and"
+	mongoOr     = "$or"
+	mongoNot    = "$not"
+	mongoSize   = "$size"
+	mongoDate   = "$date"
+)
+
+// mongoComparisons maps the MongoDB comparison operators to their operator.
+var mongoComparisons = map[string]string{
+	mongoEq:  OperatorEquals,
+	mongoNe:  OperatorNotEquals,
+	mongoGt:  OperatorGreater,
+	mongoGte: OperatorGreaterEquals,
+	mongoLt:  OperatorLess,
+	mongoLte: OperatorLessEquals,
+}
+
+// mongoMember is a member of a JSON object.
+type mongoMember struct {
+	key   string
+	value any
+}
+
+// mongoObject is a JSON object, its members kept in order so conditions are
+// chained in the order they're written.
+type mongoObject []mongoMember
+
+// ParseMongo produces a database friendly expr from a MongoDB query filter
+// in JSON, such as {"age": {"$gt": 3}, "$or": [...]}, checked against the same
+// fields as Parse, which it returns the same expression as for the equivalent
+// CEL. Conditions are chained in order. Supported operators are $eq, $ne,
+// $gt, $gte, $lt, $lte, $in and $nin, of non-empty arrays, $exists, $regex,
+// matching prefixes, suffixes or substrings of string fields only, ignoring
+// case as $options i does, $and, $or, $not and $size. Values of string array fields are matched against their
+// elements, and timestamps are given in RFC 3339, optionally as
+// {"$date": ...}. Errors are returned as *Error,
+// located within the filter for malformed JSON only.
+func (p *Parser) ParseMongo(filter string) (*Expr, error) {
+	if strings.TrimSpace(filter) == "" {
+		return &Expr{}, nil
+	}
+	doc, err := decodeMongo(filter)
+	if err != nil {
+		return nil, err
+	}
+	obj, ok := doc.(mongoObject)
+	if !ok {
+		return nil, builderError(SyntaxErrorKind, "filter must be a JSON object")
+	}
+	if len(obj) == 0 {
+		return &Expr{}, nil
+	}
+	cond, err := p.mongoFilter(obj)
+	if err != nil {
+		return nil, err
+	}
+	root, err := cond.build(p)
+	if err != nil {
+		return nil, err
+	}
+	if depthOf(root) > maxDepth {
+		return nil, builderError(DepthLimitErrorKind, "limit of %d depth level exceed", maxDepth)
+	}
+	return &Expr{Root: root}, nil
+}
+
+// decodeMongo decodes the JSON filter, keeping the members of objects in
+// order, and numbers as json.Number.
+func decodeMongo(filter string) (any, *Error) {
+	dec := json.NewDecoder(strings.NewReader(filter))
+	dec.UseNumber()
+	doc, err := decodeMongoValue(dec)
+	if err == nil {
+		if _, err = dec.Token(); err == nil {
+			err = errors.New("invalid character after top-level value")
+		} else if err == io.EOF {
+			return doc, nil
+		}
+	}
+	exprErr := builderError(SyntaxErrorKind, "%s", err)
+	var syntaxErr *json.SyntaxError
+	switch {
+	case errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || err.Error() == "unexpected end of JSON input":
+		exprErr.Msg = "unexpected end of JSON input"
+		exprErr.setOffset(filter, utf8.RuneCountInString(filter))
+	case errors.As(err, &syntaxErr) && syntaxErr.Offset > 0:
+		// the offset is the one right after the invalid byte
+		exprErr.setOffset(filter, utf8.RuneCountInString(filter[:syntaxErr.Offset-1]))
+	}
+	return nil, exprErr
+}
+
+func decodeMongoValue(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case json.Delim('{'):
+		obj := mongoObject{}
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeMongoValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			obj = append(obj, mongoMember{key: key.(string), value: value})
+		}
+		_, err := dec.Token()
+		return obj, err
+	case json.Delim('['):
+		arr := []any{}
+		for dec.More() {
+			value, err := decodeMongoValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, value)
+		}
+		_, err := dec.Token()
+		return arr, err
+	default:
+		return tok, nil
+	}
+}
+
+// mongoFilter returns the condition of the query filter, all of its members
+// being met.
+func (p *Parser) mongoFilter(obj mongoObject) (Cond, *Error) {
+	var conds []Cond
+	for _, member := range obj {
+		switch member.key {
+		case mongoAnd, mongoOr:
+			cond, err := p.mongoLogical(member.key, member.value)
+			if err != nil {
+				return nil, err
+			}
+			conds = append(conds, cond)
+		default:
+			if strings.HasPrefix(member.key, "$") {
+				return nil, mongoOperatorError(member.key, "unsupported operator %s", member.key)
+			}
+			fieldConds, err := p.mongoField(member.key, member.value)
+			if err != nil {
+				return nil, err
+			}
+			conds = append(conds, fieldConds...)
+		}
+	}
+	if len(conds) == 0 {
+		return nil, builderError(UnsupportedErrorKind, "empty filter")
+	}
+	return And(conds...), nil
+}
+
+// mongoLogical returns the condition of the filters of $and or $or.
+func (p *Parser) mongoLogical(op string, value any) (Cond, *Error) {
+	filters, ok := value.([]any)
+	if !ok || len(filters) == 0 {
+		return nil, mongoOperatorError(op, "%s requires a non-empty array of filters", op)
+	}
+	conds := make([]Cond, len(filters))
+	for i, filter := range filters {
+		obj, ok := filter.(mongoObject)
+		if !ok {
+			return nil, mongoOperatorError(op, "%s requires a non-empty array of filters", op)
+		}
+		var err *Error
+		if conds[i], err = p.mongoFilter(obj); err != nil {
+			return nil, err
+		}
+	}
+	if op == mongoAnd {
+		return And(conds...), nil
+	}
+	return Or(conds...), nil
+}
+
+// mongoField returns the conditions of the field, its equality to the value
+// or else the operators of the value.
+func (p *Parser) mongoField(name string, value any) ([]Cond, *Error) {
+	field, err := p.buildField(name)
+	if err != nil {
+		return nil, err
+	}
+	obj, ok := value.(mongoObject)
+	if !ok || isMongoDate(obj) {
+		cond, err := p.mongoOperator(field, mongoEq, value, nil)
+		if err != nil {
+			return nil, err
+		}
+		return []Cond{cond}, nil
+	}
+	if len(obj) == 0 {
+		return nil, mongoFieldError(UnsupportedErrorKind, field, "", "unsupported embedded document equality")
+	}
+	var conds []Cond
+	for _, member := range obj {
+		if member.key == mongoOpts {
+			if _, ok := obj.get(mongoRegex); !ok {
+				return nil, mongoFieldError(UnsupportedErrorKind, field, mongoOpts, "%s requires %s", mongoOpts, mongoRegex)
+			}
+			continue
+		}
+		cond, err := p.mongoOperator(field, member.key, member.value, obj)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, cond)
+	}
+	return conds, nil
+}
+
+// mongoOperator returns the condition of the operator applied to the field.
+// obj holds the operators of the field, so $regex finds its $options.
+func (p *Parser) mongoOperator(field *Field, op string, value any, obj mongoObject) (Cond, *Error) {
+	arrayField := field.Ftype == StringArrayFieldType
+	switch op {
+	case mongoEq, mongoNe:
+		// values of string arrays match any of their elements
+		if s, ok := value.(string); ok && arrayField {
+			if op == mongoNe {
+				return Not(Contains(field.Name, s)), nil
+			}
+			return Contains(field.Name, s), nil
+		}
+		fallthrough
+	case mongoGt, mongoGte, mongoLt, mongoLte:
+		v, err := mongoValue(field, op, value)
+		if err != nil {
+			return nil, err
+		}
+		return &opCond{field: field.Name, op: mongoComparisons[op], values: []any{v}}, nil
+	case mongoIn, mongoNin:
+		values, ok := value.([]any)
+		if !ok {
+			return nil, mongoFieldError(InvalidValueErrorKind, field, op, "%s requires an array", op)
+		}
+		// empty arrays are rejected alike for every field, as IN () isn't
+		// valid SQL
+		if len(values) == 0 {
+			return nil, mongoFieldError(InvalidValueErrorKind, field, op, "%s requires at least one value", op)
+		}
+		var cond Cond
+		if arrayField {
+			conds := make([]Cond, len(values))
+			for i, value := range values {
+				s, ok := value.(string)
+				if !ok {
+					return nil, mongoFieldError(TypeMismatchErrorKind, field, op, "value %v doesn't fit a field of type %s", value, field.Ftype)
+				}
+				conds[i] = Contains(field.Name, s)
+			}
+			cond = Or(conds...)
+		} else {
+			args := make([]any, len(values))
+			for i, value := range values {
+				var err *Error
+				if args[i], err = mongoValue(field, op, value); err != nil {
+					return nil, err
+				}
+			}
+			cond = In(field.Name, args...)
+		}
+		if op == mongoNin {
+			return Not(cond), nil
+		}
+		return cond, nil
+	case mongoExists:
+		exists, ok := value.(bool)
+		if !ok {
+			return nil, mongoFieldError(InvalidValueErrorKind, field, op, "%s requires a boolean", op)
+		}
+		if !exists {
+			return Not(Present(field.Name)), nil
+		}
+		return Present(field.Name), nil
+	case mongoRegex:
+		pattern, ok := value.(string)
+		if !ok {
+			return nil, mongoFieldError(InvalidValueErrorKind, field, op, "%s requires a string", op)
+		}
+		// patterns would match the elements of arrays exactly, not as patterns
+		if arrayField {
+			return nil, mongoFieldError(TypeMismatchErrorKind, field, op, "%s is unsupported by fields of type %s", op, field.Ftype)
+		}
+		// patterns are matched ignoring case, as strings are, while regular
+		// expressions are case sensitive unless told otherwise
+		if options, _ := obj.get(mongoOpts); options != "i" {
+			return nil, mongoFieldError(UnsupportedErrorKind, field, mongoOpts, "unsupported %s %v, only case insensitive patterns, with %s i, are", mongoOpts, options, mongoOpts)
+		}
+		return regexCond(field, pattern)
+	case mongoSize:
+		n, ok := value.(json.Number)
+		if !ok {
+			return nil, mongoFieldError(InvalidValueErrorKind, field, op, "%s requires an integer", op)
+		}
+		size, err := n.Int64()
+		if err != nil {
+			return nil, mongoFieldError(InvalidValueErrorKind, field, op, "%s requires an integer", op)
+		}
+		return Size(field.Name).Eq(size), nil
+	case mongoNot:
+		obj, ok := value.(mongoObject)
+		if !ok || len(obj) == 0 || isMongoDate(obj) {
+			return nil, mongoFieldError(InvalidValueErrorKind, field, op, "%s requires an object of operators", op)
+		}
+		conds, err := p.mongoField(field.Name, obj)
+		if err != nil {
+			return nil, err
+		}
+		return Not(And(conds...)), nil
+	default:
+		return nil, mongoFieldError(UnsupportedErrorKind, field, op, "unsupported operator %s", op)
+	}
+}
+
+// mongoValue converts the JSON value to the type of the args of the field:
+// numbers to integers or doubles, and RFC 3339 strings, plain or as $date, to
+// timestamps.
+func mongoValue(field *Field, op string, value any) (any, *Error) {
+	switch v := value.(type) {
+	case json.Number:
+		switch field.Ftype {
+		case IntegerFieldType:
+			if n, err := v.Int64(); err == nil {
+				return n, nil
+			}
+			// integral doubles, such as 3.0, are integers too
+			if f, err := v.Float64(); err == nil && f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 {
+				return int64(f), nil
+			}
+			return nil, mongoFieldError(InvalidValueErrorKind, field, op, "invalid %s value %s", field.Ftype, v)
+		default:
+			f, err := v.Float64()
+			if err != nil {
+				return nil, mongoFieldError(InvalidValueErrorKind, field, op, "invalid %s value %s", field.Ftype, v)
+			}
+			return f, nil
+		}
+	case mongoObject:
+		if date, ok := v.get(mongoDate); ok && isMongoDate(v) {
+			return mongoValue(field, op, date)
+		}
+		return nil, mongoFieldError(UnsupportedErrorKind, field, op, "unsupported embedded document value")
+	case string:
+		if field.Ftype != TimestampFieldType {
+			return v, nil
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, mongoFieldError(InvalidValueErrorKind, field, op, "invalid %s value %s", field.Ftype, v)
+		}
+		return t.UTC(), nil
+	default:
+		return value, nil
+	}
+}
+
+// regexCond returns the condition of the string field matching the pattern,
+// a literal anchored to the start, the end, or unanchored.
+func regexCond(field *Field, pattern string) (Cond, *Error) {
+	prefix := strings.HasPrefix(pattern, "^")
+	pattern = strings.TrimPrefix(pattern, "^")
+	var suffix bool
+	var b strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '\\' && i+1 < len(pattern) && strings.IndexByte(`\.*+?()[]{}|^$/`, pattern[i+1]) >= 0:
+			i++
+			b.WriteByte(pattern[i])
+		case c == '$' && i == len(pattern)-1:
+			suffix = true
+		case strings.IndexByte(`\.*+?()[]{}|^$`, c) >= 0:
+			return nil, mongoFieldError(UnsupportedErrorKind, field, mongoRegex, "unsupported %s pattern, only literals anchored by ^ or $ are", mongoRegex)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	literal := b.String()
+	switch {
+	case literal == "":
+		return nil, mongoFieldError(InvalidValueErrorKind, field, mongoRegex, "empty %s pattern", mongoRegex)
+	case prefix && suffix:
+		return Eq(field.Name, literal), nil
+	case prefix:
+		return StartsWith(field.Name, literal), nil
+	case suffix:
+		return EndsWith(field.Name, literal), nil
+	default:
+		return Contains(field.Name, literal), nil
+	}
+}
+
+// get returns the value of the member of the given key.
+func (obj mongoObject) get(key string) (any, bool) {
+	for _, member := range obj {
+		if member.key == key {
+			return member.value, true
+		}
+	}
+	return nil, false
+}
+
+// isMongoDate reports whether the object is an extended JSON date.
+func isMongoDate(obj mongoObject) bool {
+	return len(obj) == 1 && obj[0].key == mongoDate
+}
+
+// mongoOperatorError returns an unsupported error about the operator.
+func mongoOperatorError(op, format string, args ...any) *Error {
+	err := builderError(UnsupportedErrorKind, format, args...)
+	err.Operator = op
+	return err
+}
+
+// mongoFieldError returns an error of the given kind about the operator
+// applied to the field.
+func mongoFieldError(kind ErrorKind, field *Field, op, format string, args ...any) *Error {
+	err := builderError(kind, format, args...)
+	err.Field = field.Name
+	err.Operator = op
+	return err
+}
@@ -0,0 +1,119 @@
+package expr
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseMongo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "empty filter", input: " ", want: ""},
+		{name: "empty object", input: "{}", want: ""},
+		{name: "equality", input: `{"first_name": "paco"}`, want: `first_name == "paco"`},
+		{name: "comparisons", input: `{"age": {"$eq": 1, "$ne": 2, "$gt": 3, "$gte": 4, "$lt": 5, "$lte": 6}}`, want: "age == 1 && age != 2 && age > 3 && age >= 4 && age < 5 && age <= 6"},
+		{name: "members in order", input: `{"age": {"$gt": 3}, "active": true, "price": 1.5}`, want: "age > 3 && active == true && price == 1.5"},
+		{name: "coerced integer", input: `{"price": {"$lt": 10}}`, want: "price < 10.0"},
+		{name: "integral double", input: `{"age": 3.0}`, want: "age == 3"},
+		{name: "nested field", input: `{"company.location.zone": -1}`, want: "company.location.zone == -1"},
+		{name: "null", input: `{"birth_date": null}`, want: "birth_date == null"},
+		{name: "timestamp", input: `{"birth_date": {"$gt": "2024-01-01T01:00:00+01:00"}}`, want: "birth_date > timestamp('2024-01-01T00:00:00Z')"},
+		{name: "date", input: `{"birth_date": {"$date": "2024-01-01T00:00:00Z"}}`, want: "birth_date == timestamp('2024-01-01T00:00:00Z')"},
+		{name: "in", input: `{"age": {"$in": [1, 2, 3]}}`, want: "age in [1, 2, 3]"},
+		{name: "not in", input: `{"age": {"$nin": [1, 2]}}`, want: "!(age in [1, 2])"},
+		{name: "array element", input: `{"tags": "prod"}`, want: `tags.contains("prod")`},
+		{name: "array element not equal", input: `{"tags": {"$ne": "prod"}}`, want: `!tags.contains("prod")`},
+		{name: "array elements in", input: `{"tags": {"$in": ["prod", "dev"]}}`, want: `tags.contains("prod") || tags.contains("dev")`},
+		{name: "array elements not in", input: `{"tags": {"$nin": ["prod", "dev"]}}`, want: `!(tags.contains("prod") || tags.contains("dev"))`},
+		{name: "exists", input: `{"birth_date": {"$exists": true}}`, want: "present(birth_date)"},
+		{name: "not exists", input: `{"birth_date": {"$exists": false}}`, want: "!present(birth_date)"},
+		{name: "regex prefix", input: `{"first_name": {"$regex": "^pa", "$options": "i"}}`, want: `first_name.startsWith("pa")`},
+		{name: "regex suffix", input: `{"first_name": {"$options": "i", "$regex": "co$"}}`, want: `first_name.endsWith("co")`},
+		{name: "regex substring", input: `{"first_name": {"$regex": "a\\.c", "$options": "i"}}`, want: `first_name.contains("a.c")`},
+		{name: "regex exact", input: `{"first_name": {"$regex": "^paco$", "$options": "i"}}`, want: `first_name == "paco"`},
+		{name: "size", input: `{"tags": {"$size": 2}}`, want: "size(tags) == 2"},
+		{name: "not", input: `{"age": {"$not": {"$gt": 3, "$lt": 5}}}`, want: "!(age > 3 && age < 5)"},
+		{name: "and", input: `{"$and": [{"active": true}, {"age": 3}]}`, want: "active == true && age == 3"},
+		{name: "or", input: `{"age": {"$gt": 3}, "$or": [{"active": true}, {"tags": "prod"}]}`, want: `age > 3 && (active == true || tags.contains("prod"))`},
+	}
+
+	parser := newSQLTestParser(t)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parser.ParseMongo(tt.input)
+			if err != nil {
+				t.Fatalf("ParseMongo() error: %v", err)
+			}
+			want, err := parser.Parse(tt.want)
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ParseMongo() got: %s, want %s", formatted(got), formatted(want))
+			}
+		})
+	}
+}
+
+func TestParseMongoErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		wantKind   ErrorKind
+		wantOffset int
+		wantField  string
+	}{
+		{name: "malformed json", input: `{"age": }`, wantKind: SyntaxErrorKind, wantOffset: 8},
+		{name: "truncated json", input: `{"age": 1`, wantKind: SyntaxErrorKind, wantOffset: 9},
+		{name: "trailing data", input: `{} {}`, wantKind: SyntaxErrorKind, wantOffset: -1},
+		{name: "not an object", input: `[]`, wantKind: SyntaxErrorKind, wantOffset: -1},
+		{name: "unknown field", input: `{"frist_name": "a"}`, wantKind: UnknownFieldErrorKind, wantOffset: -1, wantField: "frist_name"},
+		{name: "unsupported operator", input: `{"age": {"$mod": [2, 0]}}`, wantKind: UnsupportedErrorKind, wantOffset: -1, wantField: "age"},
+		{name: "unsupported top level operator", input: `{"$nor": [{"active": true}]}`, wantKind: UnsupportedErrorKind, wantOffset: -1},
+		{name: "unsupported regex", input: `{"first_name": {"$regex": "^p.*o$", "$options": "i"}}`, wantKind: UnsupportedErrorKind, wantOffset: -1, wantField: "first_name"},
+		{name: "case sensitive regex", input: `{"first_name": {"$regex": "^pa"}}`, wantKind: UnsupportedErrorKind, wantOffset: -1, wantField: "first_name"},
+		{name: "empty regex options", input: `{"first_name": {"$regex": "^pa", "$options": ""}}`, wantKind: UnsupportedErrorKind, wantOffset: -1, wantField: "first_name"},
+		{name: "unsupported regex options", input: `{"first_name": {"$regex": "a", "$options": "m"}}`, wantKind: UnsupportedErrorKind, wantOffset: -1, wantField: "first_name"},
+		{name: "embedded document", input: `{"age": {}}`, wantKind: UnsupportedErrorKind, wantOffset: -1, wantField: "age"},
+		{name: "type mismatch", input: `{"age": "a"}`, wantKind: TypeMismatchErrorKind, wantOffset: -1, wantField: "age"},
+		{name: "fractional integer", input: `{"age": 1.5}`, wantKind: InvalidValueErrorKind, wantOffset: -1, wantField: "age"},
+		{name: "invalid timestamp", input: `{"birth_date": "yesterday"}`, wantKind: InvalidValueErrorKind, wantOffset: -1, wantField: "birth_date"},
+		{name: "invalid in", input: `{"age": {"$in": 1}}`, wantKind: InvalidValueErrorKind, wantOffset: -1, wantField: "age"},
+		{name: "empty in", input: `{"age": {"$in": []}}`, wantKind: InvalidValueErrorKind, wantOffset: -1, wantField: "age"},
+		{name: "empty nin", input: `{"age": {"$nin": []}}`, wantKind: InvalidValueErrorKind, wantOffset: -1, wantField: "age"},
+		{name: "empty in of array", input: `{"tags": {"$in": []}}`, wantKind: InvalidValueErrorKind, wantOffset: -1, wantField: "tags"},
+		{name: "empty nin of array", input: `{"tags": {"$nin": []}}`, wantKind: InvalidValueErrorKind, wantOffset: -1, wantField: "tags"},
+		{name: "regex of array", input: `{"tags": {"$regex": "a"}}`, wantKind: TypeMismatchErrorKind, wantOffset: -1, wantField: "tags"},
+		{name: "anchored regex of array", input: `{"tags": {"$regex": "^a"}}`, wantKind: TypeMismatchErrorKind, wantOffset: -1, wantField: "tags"},
+		{name: "invalid exists", input: `{"age": {"$exists": 1}}`, wantKind: InvalidValueErrorKind, wantOffset: -1, wantField: "age"},
+		{name: "empty or", input: `{"$or": []}`, wantKind: UnsupportedErrorKind, wantOffset: -1},
+		{name: "unsupported null", input: `{"birth_date": {"$gt": null}}`, wantKind: TypeMismatchErrorKind, wantOffset: -1, wantField: "birth_date"},
+	}
+
+	parser := newSQLTestParser(t)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := parser.ParseMongo(tt.input)
+			var exprErr *Error
+			if !errors.As(err, &exprErr) {
+				t.Fatalf("ParseMongo() error: %v, want *Error", err)
+			}
+			if exprErr.Kind != tt.wantKind || exprErr.Offset != tt.wantOffset || exprErr.Field != tt.wantField {
+				t.Errorf("ParseMongo() error: %v (kind %s, offset %d, field %q), want kind %s, offset %d, field %q",
+					exprErr, exprErr.Kind, exprErr.Offset, exprErr.Field, tt.wantKind, tt.wantOffset, tt.wantField)
+			}
+		})
+	}
+}
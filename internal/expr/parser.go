@@ -2,11 +2,8 @@ package expr
 
 import (
 	"fmt"
-	"strings"
 	"time"
 
-	"errors"
-
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/checker/decls"
 	"github.com/google/cel-go/common/operators"
@@ -18,6 +15,12 @@ const (
 	// Maximum value for the expression depth in order to validate and
 	// prematurely exit in case a too depth expression was found.
 	maxDepth = 5
+
+	// Name of the function returning the current timestamp.
+	functionNow = "now"
+
+	// Name of the function checking the presence of a field.
+	functionPresent = "present"
 )
 
 var primitiveTypeLookup = map[exprpb.Type_PrimitiveType]FieldType{
@@ -42,6 +45,8 @@ type Parser struct {
 	env          *cel.Env
 	fields       map[string]*Field
 	declarations []*exprpb.Decl
+	columns      map[string]string
+	clock        func() time.Time
 }
 
 // ParserOpt sets options such as validators.
@@ -54,11 +59,27 @@ func WithDeclarations(declarations []*exprpb.Decl) ParserOpt {
 	}
 }
 
+// WithClock overrides the clock used to resolve now(), time.Now by default.
+func WithClock(clock func() time.Time) ParserOpt {
+	return func(m *Parser) {
+		m.clock = clock
+	}
+}
+
+// WithColumns maps fields to SQL columns, see ParseColumn for the syntax of
+// the mappings. Fields without mapping use a column named after them.
+func WithColumns(columns map[string]string) ParserOpt {
+	return func(m *Parser) {
+		m.columns = columns
+	}
+}
+
 // NewParser creates a new parser
 func NewParser(allowedFields map[string]*exprpb.Type, opts ...ParserOpt) (*Parser, error) {
 	parser := &Parser{
 		fields:       make(map[string]*Field),
 		declarations: StandardDeclarations(),
+		clock:        time.Now,
 	}
 	for _, opt := range opts {
 		opt(parser)
@@ -97,9 +118,23 @@ func NewParser(allowedFields map[string]*exprpb.Type, opts ...ParserOpt) (*Parse
 		parser.fields[allowedField] = &Field{Name: allowedField, Ftype: ftype}
 	}
 
+	// map fields to their columns
+	for fieldName, mapping := range parser.columns {
+		field, ok := parser.fields[fieldName]
+		if !ok {
+			return nil, fmt.Errorf("expr: column mapping for unknown field %s", fieldName)
+		}
+		column, err := ParseColumn(mapping)
+		if err != nil {
+			return nil, err
+		}
+		field.Column = column
+	}
+
 	// build custom environment with provided declarations
 	env, err := cel.NewCustomEnv(
 		cel.HomogeneousAggregateLiterals(),
+		cel.CrossTypeNumericComparisons(true),
 		cel.Declarations(parser.declarations...),
 	)
 	if err != nil {
@@ -127,14 +162,37 @@ func StandardDeclarations() []*exprpb.Decl {
 			decls.NewOverload(overloads.Equals, []*exprpb.Type{decls.String, decls.String}, decls.Bool),
 			decls.NewOverload(overloads.Equals, []*exprpb.Type{decls.Bool, decls.Bool}, decls.Bool),
 			decls.NewOverload(overloads.Equals, []*exprpb.Type{decls.Int, decls.Int}, decls.Bool),
+			decls.NewOverload(overloads.Equals, []*exprpb.Type{decls.Double, decls.Double}, decls.Bool),
+			decls.NewOverload(overloads.Equals, []*exprpb.Type{decls.Double, decls.Int}, decls.Bool),
+			decls.NewOverload(overloads.Equals, []*exprpb.Type{decls.Timestamp, decls.Timestamp}, decls.Bool),
+			decls.NewOverload(overloads.Equals, []*exprpb.Type{decls.String, decls.Null}, decls.Bool),
+			decls.NewOverload(overloads.Equals, []*exprpb.Type{decls.Bool, decls.Null}, decls.Bool),
+			decls.NewOverload(overloads.Equals, []*exprpb.Type{decls.Int, decls.Null}, decls.Bool),
+			decls.NewOverload(overloads.Equals, []*exprpb.Type{decls.Uint, decls.Null}, decls.Bool),
+			decls.NewOverload(overloads.Equals, []*exprpb.Type{decls.Double, decls.Null}, decls.Bool),
+			decls.NewOverload(overloads.Equals, []*exprpb.Type{decls.Bytes, decls.Null}, decls.Bool),
+			decls.NewOverload(overloads.Equals, []*exprpb.Type{decls.NewListType(decls.String), decls.Null}, decls.Bool),
 		),
 		decls.NewFunction(operators.NotEquals,
 			decls.NewOverload(overloads.NotEquals, []*exprpb.Type{decls.String, decls.String}, decls.Bool),
 			decls.NewOverload(overloads.NotEquals, []*exprpb.Type{decls.Int, decls.Int}, decls.Bool),
+			decls.NewOverload(overloads.NotEquals, []*exprpb.Type{decls.Double, decls.Double}, decls.Bool),
+			decls.NewOverload(overloads.NotEquals, []*exprpb.Type{decls.Double, decls.Int}, decls.Bool),
+			decls.NewOverload(overloads.NotEquals, []*exprpb.Type{decls.Timestamp, decls.Timestamp}, decls.Bool),
+			decls.NewOverload(overloads.NotEquals, []*exprpb.Type{decls.String, decls.Null}, decls.Bool),
+			decls.NewOverload(overloads.NotEquals, []*exprpb.Type{decls.Bool, decls.Null}, decls.Bool),
+			decls.NewOverload(overloads.NotEquals, []*exprpb.Type{decls.Int, decls.Null}, decls.Bool),
+			decls.NewOverload(overloads.NotEquals, []*exprpb.Type{decls.Uint, decls.Null}, decls.Bool),
+			decls.NewOverload(overloads.NotEquals, []*exprpb.Type{decls.Double, decls.Null}, decls.Bool),
+			decls.NewOverload(overloads.NotEquals, []*exprpb.Type{decls.Bytes, decls.Null}, decls.Bool),
+			decls.NewOverload(overloads.NotEquals, []*exprpb.Type{decls.NewListType(decls.String), decls.Null}, decls.Bool),
 		),
 		decls.NewFunction(operators.In,
 			decls.NewOverload(overloads.InList, []*exprpb.Type{decls.String, decls.NewListType(decls.String)}, decls.Bool),
 			decls.NewOverload(overloads.InList, []*exprpb.Type{decls.Int, decls.NewListType(decls.Int)}, decls.Bool),
+			decls.NewOverload(overloads.InList, []*exprpb.Type{decls.Double, decls.NewListType(decls.Double)}, decls.Bool),
+			decls.NewOverload(overloads.InList, []*exprpb.Type{decls.Double, decls.NewListType(decls.Int)}, decls.Bool),
+			decls.NewOverload(overloads.InList, []*exprpb.Type{decls.Timestamp, decls.NewListType(decls.Timestamp)}, decls.Bool),
 		),
 		decls.NewFunction(overloads.Contains,
 			decls.NewInstanceOverload(overloads.ContainsString, []*exprpb.Type{decls.String, decls.String}, decls.Bool),
@@ -149,23 +207,44 @@ func StandardDeclarations() []*exprpb.Decl {
 		decls.NewFunction(operators.Less,
 			decls.NewOverload(overloads.LessTimestamp, []*exprpb.Type{decls.Timestamp, decls.Timestamp}, decls.Bool),
 			decls.NewOverload(overloads.LessInt64, []*exprpb.Type{decls.Int, decls.Int}, decls.Bool),
+			decls.NewOverload(overloads.LessDouble, []*exprpb.Type{decls.Double, decls.Double}, decls.Bool),
+			decls.NewOverload(overloads.LessDoubleInt64, []*exprpb.Type{decls.Double, decls.Int}, decls.Bool),
 		),
 		decls.NewFunction(operators.LessEquals,
 			decls.NewOverload(overloads.LessEqualsTimestamp, []*exprpb.Type{decls.Timestamp, decls.Timestamp}, decls.Bool),
 			decls.NewOverload(overloads.LessEqualsInt64, []*exprpb.Type{decls.Int, decls.Int}, decls.Bool),
+			decls.NewOverload(overloads.LessEqualsDouble, []*exprpb.Type{decls.Double, decls.Double}, decls.Bool),
+			decls.NewOverload(overloads.LessEqualsDoubleInt64, []*exprpb.Type{decls.Double, decls.Int}, decls.Bool),
 		),
 		decls.NewFunction(operators.Greater,
 			decls.NewOverload(overloads.GreaterTimestamp, []*exprpb.Type{decls.Timestamp, decls.Timestamp}, decls.Bool),
 			decls.NewOverload(overloads.GreaterInt64, []*exprpb.Type{decls.Int, decls.Int}, decls.Bool),
+			decls.NewOverload(overloads.GreaterDouble, []*exprpb.Type{decls.Double, decls.Double}, decls.Bool),
+			decls.NewOverload(overloads.GreaterDoubleInt64, []*exprpb.Type{decls.Double, decls.Int}, decls.Bool),
 		),
 		decls.NewFunction(operators.GreaterEquals,
 			decls.NewOverload(overloads.GreaterEqualsTimestamp, []*exprpb.Type{decls.Timestamp, decls.Timestamp}, decls.Bool),
 			decls.NewOverload(overloads.GreaterEqualsInt64, []*exprpb.Type{decls.Int, decls.Int}, decls.Bool),
+			decls.NewOverload(overloads.GreaterEqualsDouble, []*exprpb.Type{decls.Double, decls.Double}, decls.Bool),
+			decls.NewOverload(overloads.GreaterEqualsDoubleInt64, []*exprpb.Type{decls.Double, decls.Int}, decls.Bool),
 		),
 		decls.NewFunction(overloads.TypeConvertTimestamp,
 			decls.NewOverload(overloads.StringToTimestamp, []*exprpb.Type{decls.String}, decls.Timestamp),
 		),
-		decls.NewFunction("present",
+		decls.NewFunction(overloads.TypeConvertDuration,
+			decls.NewOverload(overloads.StringToDuration, []*exprpb.Type{decls.String}, decls.Duration),
+		),
+		decls.NewFunction(functionNow,
+			decls.NewOverload(functionNow, []*exprpb.Type{}, decls.Timestamp),
+		),
+		decls.NewFunction(operators.Add,
+			decls.NewOverload(overloads.AddTimestampDuration, []*exprpb.Type{decls.Timestamp, decls.Duration}, decls.Timestamp),
+			decls.NewOverload(overloads.AddDurationTimestamp, []*exprpb.Type{decls.Duration, decls.Timestamp}, decls.Timestamp),
+		),
+		decls.NewFunction(operators.Subtract,
+			decls.NewOverload(overloads.SubtractTimestampDuration, []*exprpb.Type{decls.Timestamp, decls.Duration}, decls.Timestamp),
+		),
+		decls.NewFunction(functionPresent,
 			decls.NewOverload("present_string", []*exprpb.Type{decls.String}, decls.Bool),
 			decls.NewOverload("present_int", []*exprpb.Type{decls.Int}, decls.Bool),
 			decls.NewOverload("present_bool", []*exprpb.Type{decls.Bool}, decls.Bool),
@@ -180,64 +259,107 @@ func StandardDeclarations() []*exprpb.Decl {
 	}
 }
 
-// Parse produces a database friendly expr from a cel string expr
+// Parse produces a database friendly expr from a cel string expr. Errors are
+// returned as *Error, located within the filter.
 func (p *Parser) Parse(filter string) (*Expr, error) {
 	if filter == "" {
 		return &Expr{}, nil
 	}
 
 	// compile filter to ast
-	ast, iss := p.env.Compile(filter)
-	if iss.Err() != nil {
-		return nil, iss.Err()
+	ast, err := p.compile(filter)
+	if err != nil {
+		return nil, err
 	}
 
 	// custom check ast
-	return p.check(ast)
+	e, err := p.check(ast)
+	if err != nil {
+		err.locate(filter, ast.SourceInfo())
+		return nil, err
+	}
+	return e, nil
 }
 
-func (p *Parser) check(ast *cel.Ast) (*Expr, error) {
-	switch exprKind := ast.Expr().ExprKind.(type) {
-	case *exprpb.Expr_CallExpr:
-		n, err := p.walk(exprKind.CallExpr, 0)
-		if err != nil {
-			return nil, err
-		}
-		return &Expr{Root: n}, nil
-	default:
-		return nil, fmt.Errorf("expr: unsupported expression of kind %T", exprKind)
+// compile parses and type checks the filter, apart so syntax errors can be
+// told apart.
+func (p *Parser) compile(filter string) (*cel.Ast, *Error) {
+	parsed, iss := p.env.Parse(filter)
+	if iss.Err() != nil {
+		return nil, p.issueError(filter, nil, iss)
 	}
+	checked, iss := p.env.Check(parsed)
+	if iss.Err() != nil {
+		return nil, p.issueError(filter, parsed, iss)
+	}
+	return checked, nil
 }
 
-func (p *Parser) walk(callExpr *exprpb.Expr_Call, depth int) (Node, error) {
+func (p *Parser) check(ast *cel.Ast) (*Expr, *Error) {
+	n, err := p.walk(ast.Expr(), 0)
+	if err != nil {
+		return nil, err
+	}
+	return &Expr{Root: n}, nil
+}
+
+func (p *Parser) walk(expr *exprpb.Expr, depth int) (Node, *Error) {
 	depth++
 	if depth > maxDepth {
-		return nil, fmt.Errorf("expr: limit of %d depth level exceed", maxDepth)
+		return nil, newError(DepthLimitErrorKind, expr, "limit of %d depth level exceed", maxDepth)
+	}
+
+	switch exprKind := expr.ExprKind.(type) {
+	case *exprpb.Expr_IdentExpr:
+		// bare boolean fields are predicates on their own
+		field, err := p.field(expr)
+		if err != nil {
+			return nil, err
+		}
+		if field.Ftype != BoolFieldType {
+			err := newError(TypeMismatchErrorKind, expr, "field %s is not a boolean", field.Name)
+			err.Field = field.Name
+			return nil, err
+		}
+		return field, nil
+	case *exprpb.Expr_CallExpr:
+		return p.walkCall(expr, depth)
+	default:
+		return nil, newError(UnsupportedErrorKind, expr, "unsupported expression of kind %T", exprKind)
 	}
+}
 
+func (p *Parser) walkCall(expr *exprpb.Expr, depth int) (Node, *Error) {
+	callExpr := expr.GetCallExpr()
 	switch callExpr.Function {
 	case operators.Equals, operators.NotEquals, operators.In, operators.Greater, operators.GreaterEquals, operators.Less, operators.LessEquals:
 		if len(callExpr.Args) != 2 {
-			return nil, errors.New("expr: invalid number of arguments")
+			return nil, argumentsError(expr)
 		}
-		return p.opExpr(callExpr.Function, callExpr.Args[0], callExpr.Args[1])
+		return p.opExpr(expr, callExpr.Args[0], callExpr.Args[1])
 	case overloads.StartsWith, overloads.EndsWith, overloads.Contains:
-		if len(callExpr.Args) != 1 {
-			return nil, errors.New("expr: invalid number of arguments")
+		if len(callExpr.Args) != 1 || callExpr.Target == nil {
+			return nil, argumentsError(expr)
 		}
-		return p.opExpr(callExpr.Function, callExpr.Target, callExpr.Args[0])
+		return p.opExpr(expr, callExpr.Target, callExpr.Args[0])
 	case operators.LogicalNot:
-		expr, err := p.walk(callExpr.Args[0].ExprKind.(*exprpb.Expr_CallExpr).CallExpr, depth)
+		if len(callExpr.Args) != 1 {
+			return nil, argumentsError(expr)
+		}
+		expr, err := p.walk(callExpr.Args[0], depth)
 		if err != nil {
 			return nil, err
 		}
 		return &NotExpr{Not: expr}, nil
 	case operators.LogicalAnd, operators.LogicalOr:
-		left, err := p.walk(callExpr.Args[0].ExprKind.(*exprpb.Expr_CallExpr).CallExpr, depth)
+		if len(callExpr.Args) != 2 {
+			return nil, argumentsError(expr)
+		}
+		left, err := p.walk(callExpr.Args[0], depth)
 		if err != nil {
 			return nil, err
 		}
-		right, err := p.walk(callExpr.Args[1].ExprKind.(*exprpb.Expr_CallExpr).CallExpr, depth)
+		right, err := p.walk(callExpr.Args[1], depth)
 		if err != nil {
 			return nil, err
 		}
@@ -245,83 +367,183 @@ func (p *Parser) walk(callExpr *exprpb.Expr_Call, depth int) (Node, error) {
 			return &AndExpr{Left: left, Right: right}, nil
 		}
 		return &OrExpr{Left: left, Right: right}, nil
-	case "present":
-		identExpr, ok := callExpr.Args[0].ExprKind.(*exprpb.Expr_IdentExpr)
-		if !ok {
-			return nil, errors.New("expr: failed to cast to ident expression")
+	case functionPresent:
+		field, err := p.identField(expr)
+		if err != nil {
+			return nil, err
 		}
-		return &PresentExpr{Field: p.fields[identExpr.IdentExpr.Name]}, nil
+		return &PresentExpr{Field: field}, nil
 	case overloads.Size:
-		identExpr, ok := callExpr.Args[0].ExprKind.(*exprpb.Expr_IdentExpr)
-		if !ok {
-			return nil, errors.New("expr: failed to cast to ident expression")
+		field, err := p.identField(expr)
+		if err != nil {
+			return nil, err
 		}
-		return &SizeExpr{Field: p.fields[identExpr.IdentExpr.Name]}, nil
+		return &SizeExpr{Field: field}, nil
 	default:
-		return nil, errors.New("expr: unsupported call expression function")
+		err := newError(UnsupportedErrorKind, expr, "unsupported function %s", callExpr.Function)
+		err.Operator = operatorName(callExpr.Function)
+		return nil, err
+	}
+}
+
+// argumentsError returns the error of a call with an invalid number of
+// arguments.
+func argumentsError(expr *exprpb.Expr) *Error {
+	err := newError(UnsupportedErrorKind, expr, "invalid number of arguments")
+	err.Operator = operatorName(expr.GetCallExpr().GetFunction())
+	return err
+}
+
+// identField returns the field passed as the only argument of a call.
+func (p *Parser) identField(expr *exprpb.Expr) (*Field, *Error) {
+	callExpr := expr.GetCallExpr()
+	if len(callExpr.Args) != 1 {
+		return nil, argumentsError(expr)
+	}
+	if _, ok := callExpr.Args[0].ExprKind.(*exprpb.Expr_IdentExpr); !ok {
+		err := newError(UnsupportedErrorKind, callExpr.Args[0], "%s only accepts a field", callExpr.Function)
+		err.Operator = callExpr.Function
+		return nil, err
+	}
+	return p.field(callExpr.Args[0])
+}
+
+// field returns the field referenced by the ident expression.
+func (p *Parser) field(expr *exprpb.Expr) (*Field, *Error) {
+	name := expr.GetIdentExpr().GetName()
+	field, ok := p.fields[name]
+	if !ok {
+		err := newError(UnknownFieldErrorKind, expr, "unknown field %s", name)
+		err.Field = name
+		err.Suggestions = p.suggestFields(name)
+		return nil, err
 	}
+	return field, nil
 }
 
-func (p *Parser) opExpr(op string, leftExpr, rightExpr *exprpb.Expr) (*OpExpr, error) {
+func (p *Parser) opExpr(expr, leftExpr, rightExpr *exprpb.Expr) (*OpExpr, *Error) {
+	op := operatorName(expr.GetCallExpr().GetFunction())
+
 	var left Node
+	var field *Field
 	switch kind := leftExpr.ExprKind.(type) {
 	case *exprpb.Expr_IdentExpr:
-		left = p.fields[kind.IdentExpr.Name]
+		var err *Error
+		field, err = p.field(leftExpr)
+		if err != nil {
+			return nil, err
+		}
+		left = field
 	case *exprpb.Expr_CallExpr:
-		var err error
-		left, err = p.walk(kind.CallExpr, 1)
+		if kind.CallExpr.Function != overloads.Size {
+			return nil, operandError(leftExpr, op, "unsupported left expression")
+		}
+		var err *Error
+		left, err = p.walkCall(leftExpr, 1)
 		if err != nil {
 			return nil, err
 		}
+		field = left.(*SizeExpr).Field
 	default:
-		return nil, errors.New("expr: unsupported left expression")
+		return nil, operandError(leftExpr, op, "unsupported left expression")
+	}
+
+	// errors of the right side refer to the field being compared
+	withField := func(err *Error) *Error {
+		err.Field = field.Name
+		if err.Operator == "" {
+			err.Operator = op
+		}
+		return err
 	}
 
 	var args []any
 	if listExpr, ok := rightExpr.ExprKind.(*exprpb.Expr_ListExpr); ok {
+		// in requires values, as IN () isn't valid SQL
+		if len(listExpr.ListExpr.GetElements()) == 0 {
+			return nil, withField(newError(InvalidValueErrorKind, rightExpr, "%s requires at least one value", op))
+		}
 		for _, elemExpr := range listExpr.ListExpr.GetElements() {
-			arg, err := value(elemExpr.ExprKind)
+			arg, err := p.value(elemExpr)
 			if err != nil {
-				return nil, err
+				return nil, withField(err)
 			}
 			args = append(args, arg)
 		}
 	} else {
-		arg, err := value(rightExpr.ExprKind)
+		arg, err := p.value(rightExpr)
 		if err != nil {
-			return nil, err
+			return nil, withField(err)
 		}
 		args = []any{arg}
 	}
 
-	return &OpExpr{
+	// coerce int args of double fields, so both `price > 10` and
+	// `price > 10.0` produce the same expression
+	if field, ok := left.(*Field); ok && field.Ftype == DoubleFieldType {
+		for i, arg := range args {
+			if v, ok := arg.(int64); ok {
+				args[i] = float64(v)
+			}
+		}
+	}
+
+	opExpr := &OpExpr{
 		Left: left,
-		Op:   strings.Trim(strings.Trim(op, "_"), "@"),
+		Op:   op,
 		Args: args,
-	}, nil
+	}
+
+	// null is accepted where nullable types are, such as timestamps, but it's
+	// only meaningful for equality and inequality
+	for _, arg := range args {
+		if arg == nil && !isNullComparison(opExpr) {
+			return nil, withField(newError(TypeMismatchErrorKind, rightExpr, "null is only supported by == and != operators"))
+		}
+	}
+
+	return opExpr, nil
 }
 
-func value(expr any) (any, error) {
+// operandError returns an unsupported error about the operand of an operator.
+func operandError(operand *exprpb.Expr, op, msg string) *Error {
+	err := newError(UnsupportedErrorKind, operand, "%s", msg)
+	err.Operator = op
+	return err
+}
+
+func (p *Parser) value(expr *exprpb.Expr) (any, *Error) {
 	var constant *exprpb.Constant
-	var isTimestamp bool
-	switch valueExpr := expr.(type) {
+	var conversion string
+	literal := expr
+	switch valueExpr := expr.ExprKind.(type) {
 	case *exprpb.Expr_ConstExpr:
 		constant = valueExpr.ConstExpr
 	case *exprpb.Expr_CallExpr:
-		if len(valueExpr.CallExpr.Args) != 1 {
-			return nil, errors.New("expr: invalid number of arguments")
-		}
-		if valueExpr.CallExpr.Function != overloads.TypeConvertTimestamp {
-			return nil, errors.New("expr: unsupported type for call expression")
-		}
-		constExpr, ok := valueExpr.CallExpr.Args[0].ExprKind.(*exprpb.Expr_ConstExpr)
-		if !ok {
-			return nil, errors.New("expr: invalid argument type")
+		switch valueExpr.CallExpr.Function {
+		case overloads.TypeConvertTimestamp, overloads.TypeConvertDuration:
+			if len(valueExpr.CallExpr.Args) != 1 {
+				return nil, argumentsError(expr)
+			}
+			constExpr, ok := valueExpr.CallExpr.Args[0].ExprKind.(*exprpb.Expr_ConstExpr)
+			if !ok {
+				return nil, newError(UnsupportedErrorKind, valueExpr.CallExpr.Args[0], "%s only accepts a literal", valueExpr.CallExpr.Function)
+			}
+			constant = constExpr.ConstExpr
+			literal = valueExpr.CallExpr.Args[0]
+			conversion = valueExpr.CallExpr.Function
+		case functionNow:
+			if len(valueExpr.CallExpr.Args) != 0 {
+				return nil, argumentsError(expr)
+			}
+			return p.clock().UTC(), nil
+		case operators.Add, operators.Subtract:
+			return p.timeArithmetic(expr)
+		default:
+			return nil, newError(UnsupportedErrorKind, expr, "unsupported function %s in value", valueExpr.CallExpr.Function)
 		}
-		constant = constExpr.ConstExpr
-		isTimestamp = true
 	default:
-		return nil, errors.New("expr: unsupported type for value")
+		return nil, newError(UnsupportedErrorKind, expr, "unsupported value, only literals are allowed")
 	}
 
 	switch constKind := constant.ConstantKind.(type) {
@@ -336,17 +558,57 @@ func value(expr any) (any, error) {
 	case *exprpb.Constant_NullValue:
 		return nil, nil
 	case *exprpb.Constant_StringValue:
-		if isTimestamp {
+		switch conversion {
+		case overloads.TypeConvertTimestamp:
 			t, err := time.Parse(time.RFC3339, constKind.StringValue)
 			if err != nil {
-				return nil, fmt.Errorf("expr: failed to parse time: %v", err)
+				return nil, newError(InvalidValueErrorKind, literal, "failed to parse time: %v", err)
 			}
 			return t.UTC(), nil
+		case overloads.TypeConvertDuration:
+			d, err := time.ParseDuration(constKind.StringValue)
+			if err != nil {
+				return nil, newError(InvalidValueErrorKind, literal, "failed to parse duration: %v", err)
+			}
+			return d, nil
 		}
 		return constKind.StringValue, nil
 	case *exprpb.Constant_Uint64Value:
 		return constKind.Uint64Value, nil
 	default:
-		return nil, fmt.Errorf("expr: constant expression of kind %T not supported", constKind)
+		return nil, newError(UnsupportedErrorKind, expr, "constant expression of kind %T not supported", constKind)
+	}
+}
+
+// timeArithmetic folds the addition or subtraction of a duration to a
+// timestamp into a concrete timestamp.
+func (p *Parser) timeArithmetic(expr *exprpb.Expr) (any, *Error) {
+	callExpr := expr.GetCallExpr()
+	if len(callExpr.Args) != 2 {
+		return nil, argumentsError(expr)
+	}
+	left, err := p.value(callExpr.Args[0])
+	if err != nil {
+		return nil, err
+	}
+	right, err := p.value(callExpr.Args[1])
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case isTimestampDuration(left, right) && callExpr.Function == operators.Add:
+		return left.(time.Time).Add(right.(time.Duration)), nil
+	case isTimestampDuration(left, right) && callExpr.Function == operators.Subtract:
+		return left.(time.Time).Add(-right.(time.Duration)), nil
+	case isTimestampDuration(right, left) && callExpr.Function == operators.Add:
+		return right.(time.Time).Add(left.(time.Duration)), nil
+	default:
+		return nil, newError(UnsupportedErrorKind, expr, "unsupported arithmetic operation")
 	}
 }
+
+func isTimestampDuration(t, d any) bool {
+	_, isTimestamp := t.(time.Time)
+	_, isDuration := d.(time.Duration)
+	return isTimestamp && isDuration
+}
@@ -28,8 +28,11 @@ func TestParse(t *testing.T) {
 	companyLocationZone := &Field{Name: "company.location.zone", Ftype: IntegerFieldType}
 	companyFortune500 := &Field{Name: "company.fortune500", Ftype: BoolFieldType}
 	age := &Field{Name: "age", Ftype: IntegerFieldType}
+	price := &Field{Name: "price", Ftype: DoubleFieldType}
+	active := &Field{Name: "active", Ftype: BoolFieldType}
 	birthDate := &Field{Name: "birth_date", Ftype: TimestampFieldType}
 	tags := &Field{Name: "tags", Ftype: StringArrayFieldType}
+	now := mustParseTimestamp(t, "2024-05-21T14:30:00Z")
 
 	tests := []struct {
 		name    string
@@ -167,6 +170,97 @@ func TestParse(t *testing.T) {
 			input: "age in [2, 15, 35]",
 			want:  &Expr{Root: &OpExpr{Left: age, Op: "in", Args: []any{int64(2), int64(15), int64(35)}}},
 		},
+		{
+			name:  "equality with double value",
+			input: "price == 10.5",
+			want:  &Expr{Root: &OpExpr{Left: price, Op: "==", Args: []any{10.5}}},
+		},
+		{
+			name:  "not equals with double value",
+			input: "price != 10.5",
+			want:  &Expr{Root: &OpExpr{Left: price, Op: "!=", Args: []any{10.5}}},
+		},
+		{
+			name:  "greater than with double value coerced from int",
+			input: "price > 10",
+			want:  &Expr{Root: &OpExpr{Left: price, Op: ">", Args: []any{float64(10)}}},
+		},
+		{
+			name:  "greaterEquals than with negative double value",
+			input: "price >= -0.5",
+			want:  &Expr{Root: &OpExpr{Left: price, Op: ">=", Args: []any{-0.5}}},
+		},
+		{
+			name:  "less than with double value",
+			input: "price < 1e3",
+			want:  &Expr{Root: &OpExpr{Left: price, Op: "<", Args: []any{1000.0}}},
+		},
+		{
+			name:  "lessEquals than with double value coerced from int",
+			input: "price <= 7",
+			want:  &Expr{Root: &OpExpr{Left: price, Op: "<=", Args: []any{float64(7)}}},
+		},
+		{
+			name:  "in with double values",
+			input: "price in [1.5, 2.5]",
+			want:  &Expr{Root: &OpExpr{Left: price, Op: "in", Args: []any{1.5, 2.5}}},
+		},
+		{
+			name:  "in with double values coerced from int",
+			input: "price in [1, 2]",
+			want:  &Expr{Root: &OpExpr{Left: price, Op: "in", Args: []any{float64(1), float64(2)}}},
+		},
+		{
+			name:    "disallow comparing int field against double value",
+			input:   "age > 10.5",
+			wantErr: true,
+		},
+		{
+			name:    "disallow mixed int and double list values",
+			input:   "price in [1, 2.5]",
+			wantErr: true,
+		},
+		{
+			name:  "bool field",
+			input: "active",
+			want:  &Expr{Root: active},
+		},
+		{
+			name:  "not bool field",
+			input: "!active",
+			want:  &Expr{Root: &NotExpr{Not: active}},
+		},
+		{
+			name:  "bool fields within and/or",
+			input: "!active && age > 18 || company.fortune500",
+			want: &Expr{Root: &OrExpr{
+				Left: &AndExpr{
+					Left:  &NotExpr{Not: active},
+					Right: &OpExpr{Left: age, Op: ">", Args: []any{int64(18)}},
+				},
+				Right: companyFortune500,
+			}},
+		},
+		{
+			name:    "disallow non bool field as predicate",
+			input:   "first_name",
+			wantErr: true,
+		},
+		{
+			name:    "disallow constant as predicate",
+			input:   "true",
+			wantErr: true,
+		},
+		{
+			name:    "disallow constant within and/or",
+			input:   "active && true",
+			wantErr: true,
+		},
+		{
+			name:    "disallow comparing predicates",
+			input:   "(age > 18) == active",
+			wantErr: true,
+		},
 		{
 			name:    "exceed max expression depth",
 			input:   strings.TrimSuffix(strings.Repeat("first_name == 'A' ||", 17), " ||"),
@@ -221,6 +315,69 @@ func TestParse(t *testing.T) {
 			want:    &Expr{Root: &OpExpr{Left: birthDate, Op: "<=", Args: []any{mustParseTimestamp(t, "1983-12-10T11:03:30Z")}}},
 			wantErr: false,
 		},
+		{
+			name:  "equality with timestamp value",
+			input: `birth_date == timestamp("1983-12-10T11:03:27Z")`,
+			want:  &Expr{Root: &OpExpr{Left: birthDate, Op: "==", Args: []any{mustParseTimestamp(t, "1983-12-10T11:03:27Z")}}},
+		},
+		{
+			name:  "not equals with timestamp value with offset",
+			input: `birth_date != timestamp("1983-12-10T12:03:27+01:00")`,
+			want:  &Expr{Root: &OpExpr{Left: birthDate, Op: "!=", Args: []any{mustParseTimestamp(t, "1983-12-10T11:03:27Z")}}},
+		},
+		{
+			name:  "in with timestamp values",
+			input: `birth_date in [timestamp("1983-12-10T11:03:27Z"), timestamp("2024-01-01T00:00:00Z")]`,
+			want: &Expr{Root: &OpExpr{Left: birthDate, Op: "in", Args: []any{
+				mustParseTimestamp(t, "1983-12-10T11:03:27Z"),
+				mustParseTimestamp(t, "2024-01-01T00:00:00Z"),
+			}}},
+		},
+		{
+			name:    "disallow in with incorrect timestamp string format",
+			input:   `birth_date in [timestamp("1983-12-10T11:03:27Z"), timestamp("foo")]`,
+			wantErr: true,
+		},
+		{
+			name:    "disallow comparing timestamp field against string value",
+			input:   `birth_date == "1983-12-10T11:03:27Z"`,
+			wantErr: true,
+		},
+		{
+			name:  "greater than with now",
+			input: `birth_date > now()`,
+			want:  &Expr{Root: &OpExpr{Left: birthDate, Op: ">", Args: []any{now}}},
+		},
+		{
+			name:  "greater than with now minus duration",
+			input: `birth_date > now() - duration("168h")`,
+			want:  &Expr{Root: &OpExpr{Left: birthDate, Op: ">", Args: []any{now.Add(-168 * time.Hour)}}},
+		},
+		{
+			name:  "less than with timestamp plus duration",
+			input: `birth_date < timestamp("1983-12-10T11:03:27Z") + duration("1h30m")`,
+			want:  &Expr{Root: &OpExpr{Left: birthDate, Op: "<", Args: []any{mustParseTimestamp(t, "1983-12-10T12:33:27Z")}}},
+		},
+		{
+			name:  "in with relative timestamp values",
+			input: `birth_date in [now(), duration("24h") + now() - duration("1h")]`,
+			want:  &Expr{Root: &OpExpr{Left: birthDate, Op: "in", Args: []any{now, now.Add(23 * time.Hour)}}},
+		},
+		{
+			name:    "disallow incorrect duration string format",
+			input:   `birth_date > now() - duration("foo")`,
+			wantErr: true,
+		},
+		{
+			name:    "disallow arithmetic on fields",
+			input:   `birth_date + duration("1h") > now()`,
+			wantErr: true,
+		},
+		{
+			name:    "disallow subtracting timestamps",
+			input:   `birth_date > now() - now()`,
+			wantErr: true,
+		},
 		{
 			name:    "disallow comparing against incorrect timestamp string format",
 			input:   `birth_date > timestamp("foo")`,
@@ -236,11 +393,65 @@ func TestParse(t *testing.T) {
 			input: "present(age)",
 			want:  &Expr{Root: &PresentExpr{Field: age}},
 		},
+		{
+			name:  "present double",
+			input: "present(price)",
+			want:  &Expr{Root: &PresentExpr{Field: price}},
+		},
 		{
 			name:  "present nested",
 			input: "present(company.name)",
 			want:  &Expr{Root: &PresentExpr{Field: companyName}},
 		},
+		{
+			name:  "not present",
+			input: "!present(first_name)",
+			want:  &Expr{Root: &NotExpr{Not: &PresentExpr{Field: firstName}}},
+		},
+		{
+			name:  "equality with null",
+			input: "first_name == null",
+			want:  &Expr{Root: &OpExpr{Left: firstName, Op: "==", Args: []any{nil}}},
+		},
+		{
+			name:  "not equals with null",
+			input: "birth_date != null",
+			want:  &Expr{Root: &OpExpr{Left: birthDate, Op: "!=", Args: []any{nil}}},
+		},
+		{
+			name:  "equality with null for every field type",
+			input: "age == null && price == null && company.fortune500 == null && tags == null",
+			want: &Expr{Root: &AndExpr{
+				Left: &AndExpr{
+					Left:  &OpExpr{Left: age, Op: "==", Args: []any{nil}},
+					Right: &OpExpr{Left: price, Op: "==", Args: []any{nil}},
+				},
+				Right: &AndExpr{
+					Left:  &OpExpr{Left: companyFortune500, Op: "==", Args: []any{nil}},
+					Right: &OpExpr{Left: tags, Op: "==", Args: []any{nil}},
+				},
+			}},
+		},
+		{
+			name:    "disallow null in list values",
+			input:   "first_name in [null]",
+			wantErr: true,
+		},
+		{
+			name:    "disallow ordering against null",
+			input:   "age > null",
+			wantErr: true,
+		},
+		{
+			name:    "disallow ordering timestamps against null",
+			input:   "birth_date > null",
+			wantErr: true,
+		},
+		{
+			name:    "disallow null in timestamp list values",
+			input:   "birth_date in [null]",
+			wantErr: true,
+		},
 		{
 			name:  "size with equals",
 			input: "size(tags) == 0",
@@ -282,11 +493,13 @@ func TestParse(t *testing.T) {
 		"company.location.zone":   {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_INT64}},
 		"company.fortune500":      {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_BOOL}},
 		"age":                     {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_INT64}},
+		"price":                   {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_DOUBLE}},
+		"active":                  {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_BOOL}},
 		"birth_date":              {TypeKind: &exprpb.Type_WellKnown{WellKnown: exprpb.Type_TIMESTAMP}},
 		"tags": {TypeKind: &exprpb.Type_ListType_{ListType: &exprpb.Type_ListType{
 			ElemType: &exprpb.Type{TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
 		}}},
-	})
+	}, WithClock(func() time.Time { return now.In(time.FixedZone("CET", 3600)) }))
 	if err != nil {
 		t.Fatalf("%v", err)
 	}
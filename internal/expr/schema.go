@@ -0,0 +1,85 @@
+package expr
+
+import (
+	"sort"
+
+	"github.com/google/cel-go/checker/decls"
+	"github.com/google/cel-go/common/overloads"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+// fieldTypeDecls maps field types to the CEL types fields are declared with.
+var fieldTypeDecls = map[FieldType][]*exprpb.Type{
+	BoolFieldType:        {decls.Bool},
+	IntegerFieldType:     {decls.Int, decls.Uint},
+	DoubleFieldType:      {decls.Double},
+	StringFieldType:      {decls.String},
+	BytesFieldType:       {decls.Bytes},
+	TimestampFieldType:   {decls.Timestamp},
+	StringArrayFieldType: {decls.NewListType(decls.String)},
+}
+
+// Fields returns the fields known by the parser, sorted by name.
+func (p *Parser) Fields() []*Field {
+	fields := make([]*Field, 0, len(p.fields))
+	for _, field := range p.fields {
+		fields = append(fields, field)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Name < fields[j].Name
+	})
+	return fields
+}
+
+// Field returns the field of the given name known by the parser.
+func (p *Parser) Field(name string) (*Field, bool) {
+	field, ok := p.fields[name]
+	return field, ok
+}
+
+// Operators returns the operators fields of the given type can be used with,
+// in order of declaration. An operator is valid when declared for the type
+// and translatable to SQL, present and size being translated on their own.
+func (p *Parser) Operators(ftype FieldType) []string {
+	var ops []string
+	seen := make(map[string]bool)
+	for _, decl := range p.declarations {
+		function := decl.GetFunction()
+		if function == nil {
+			continue
+		}
+		op := operatorName(decl.GetName())
+		if seen[op] || !declaresOperand(function, ftype) {
+			continue
+		}
+		switch decl.GetName() {
+		case functionPresent, overloads.Size:
+		default:
+			if _, ok := sqlOperatorLookup[op][ftype]; !ok {
+				continue
+			}
+		}
+		seen[op] = true
+		ops = append(ops, op)
+	}
+	return ops
+}
+
+// declaresOperand reports whether any overload of the function accepts a
+// field of the given type as its first operand, which is the target of
+// member functions.
+func declaresOperand(function *exprpb.Decl_FunctionDecl, ftype FieldType) bool {
+	for _, overload := range function.GetOverloads() {
+		params := overload.GetParams()
+		if len(params) == 0 {
+			continue
+		}
+		for _, declType := range fieldTypeDecls[ftype] {
+			if proto.Equal(params[0], declType) {
+				return true
+			}
+		}
+	}
+	return false
+}
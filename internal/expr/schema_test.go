@@ -0,0 +1,60 @@
+package expr
+
+import (
+	"reflect"
+	"testing"
+
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+func TestFields(t *testing.T) {
+	t.Parallel()
+
+	parser, err := NewParser(map[string]*exprpb.Type{
+		"name": {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+		"age":  {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_INT64}},
+	}, WithColumns(map[string]string{"name": "u.display_name"}))
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	want := []*Field{
+		{Name: "age", Ftype: IntegerFieldType},
+		{Name: "name", Ftype: StringFieldType, Column: &Column{Name: []string{"u", "display_name"}}},
+	}
+	if got := parser.Fields(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Fields() got: %v, want %v", got, want)
+	}
+}
+
+func TestOperators(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ftype FieldType
+		want  []string
+	}{
+		{ftype: BoolFieldType, want: []string{"==", "present"}},
+		{ftype: IntegerFieldType, want: []string{"==", "!=", "in", "<", "<=", ">", ">=", "present"}},
+		{ftype: DoubleFieldType, want: []string{"==", "!=", "in", "<", "<=", ">", ">=", "present"}},
+		{ftype: StringFieldType, want: []string{"==", "!=", "in", "contains", "endsWith", "startsWith", "present"}},
+		{ftype: BytesFieldType, want: []string{"present"}},
+		{ftype: TimestampFieldType, want: []string{"==", "!=", "in", "<", "<=", ">", ">=", "present"}},
+		{ftype: StringArrayFieldType, want: []string{"contains", "size"}},
+	}
+
+	parser, err := NewParser(nil)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.ftype.String(), func(t *testing.T) {
+			t.Parallel()
+			if got := parser.Operators(tt.ftype); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Operators() got: %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,353 @@
+package expr
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SCIM keywords, matched case insensitively.
+const (
+	scimAnd     = "and"
+	scimOr      = "or"
+	scimNot     = "not"
+	scimPresent = "pr"
+)
+
+// scimComparisons maps the SCIM comparison operators to their operator.
+var scimComparisons = map[string]string{
+	"eq": OperatorEquals,
+	"ne": OperatorNotEquals,
+	"co": OperatorContains,
+	"sw": OperatorStartsWith,
+	"ew": OperatorEndsWith,
+	"gt": OperatorGreater,
+	"ge": OperatorGreaterEquals,
+	"lt": OperatorLess,
+	"le": OperatorLessEquals,
+}
+
+// scimTokenKind is the kind of a SCIM token.
+type scimTokenKind int
+
+const (
+	scimEOF scimTokenKind = iota
+	scimWord
+	scimString
+	scimLParen
+	scimRParen
+	scimLBracket
+	scimRBracket
+)
+
+// scimDelimiters maps the delimiters of SCIM filters to their token kind.
+var scimDelimiters = map[rune]scimTokenKind{
+	'(': scimLParen,
+	')': scimRParen,
+	'[': scimLBracket,
+	']': scimRBracket,
+}
+
+// scimToken is a token of a SCIM filter, located by its byte offsets.
+type scimToken struct {
+	kind scimTokenKind
+	// text is the text of the token, unquoted for strings.
+	text   string
+	offset int
+	end    int
+}
+
+// ParseSCIM produces a database friendly expr from a SCIM 2.0 filter, as of
+// RFC 7644, such as userName eq "bjensen" and emails[type eq "work"], checked
+// against the same fields as Parse, which it returns the same expression as
+// for the equivalent CEL. Attribute names are matched case insensitively,
+// without their schema URN, and the attributes of the filters of value paths
+// are the sub-attributes of their path, so emails[type eq "work"] filters the
+// emails.type field. Multi-valued attributes are compared by eq and ne only,
+// matching any of their elements. Errors are returned as *Error, located
+// within the filter.
+func (p *Parser) ParseSCIM(filter string) (*Expr, error) {
+	if strings.TrimSpace(filter) == "" {
+		return &Expr{}, nil
+	}
+	tokens, err := lexSCIM(filter)
+	if err != nil {
+		return nil, err
+	}
+	sp := &scimParser{parser: p, filter: filter, tokens: tokens}
+	root, err := sp.or("")
+	if err != nil {
+		return nil, err
+	}
+	if tok := sp.peek(); tok.kind != scimEOF {
+		return nil, sp.errorAt(tok, SyntaxErrorKind, "unexpected %s", tok.text)
+	}
+	if depthOf(root) > maxDepth {
+		return nil, sp.errorAt(tokens[0], DepthLimitErrorKind, "limit of %d depth level exceed", maxDepth)
+	}
+	return &Expr{Root: root}, nil
+}
+
+// lexSCIM splits the filter into tokens, ended by an EOF one.
+func lexSCIM(filter string) ([]scimToken, *Error) {
+	var tokens []scimToken
+	for i := 0; i < len(filter); {
+		r, size := utf8.DecodeRuneInString(filter[i:])
+		start := i
+		switch r {
+		case '(', ')', '[', ']':
+			tokens = append(tokens, scimToken{kind: scimDelimiters[r], text: string(r), offset: start, end: i + 1})
+			i++
+		case '"':
+			end := tokenEnd(filter, i)
+			var text string
+			if err := json.Unmarshal([]byte(filter[start:end]), &text); err != nil {
+				err := builderError(SyntaxErrorKind, "invalid string %s", filter[start:end])
+				err.setSpan(filter, start, end)
+				return nil, err
+			}
+			tokens = append(tokens, scimToken{kind: scimString, text: text, offset: start, end: end})
+			i = end
+		default:
+			if unicode.IsSpace(r) {
+				i += size
+				continue
+			}
+			for i < len(filter) {
+				r, size := utf8.DecodeRuneInString(filter[i:])
+				if unicode.IsSpace(r) || strings.ContainsRune(`()[]"`, r) {
+					break
+				}
+				i += size
+			}
+			tokens = append(tokens, scimToken{kind: scimWord, text: filter[start:i], offset: start, end: i})
+		}
+	}
+	return append(tokens, scimToken{kind: scimEOF, text: "end of filter", offset: len(filter), end: len(filter)}), nil
+}
+
+// scimParser is a recursive descent parser of SCIM filters.
+type scimParser struct {
+	parser *Parser
+	filter string
+	tokens []scimToken
+	pos    int
+}
+
+func (sp *scimParser) peek() scimToken {
+	return sp.tokens[sp.pos]
+}
+
+func (sp *scimParser) next() scimToken {
+	tok := sp.tokens[sp.pos]
+	if tok.kind != scimEOF {
+		sp.pos++
+	}
+	return tok
+}
+
+func (sp *scimParser) errorAt(tok scimToken, kind ErrorKind, format string, args ...any) *Error {
+	err := builderError(kind, format, args...)
+	err.setSpan(sp.filter, tok.offset, tok.end)
+	return err
+}
+
+// isSCIMKeyword reports whether the token is the given keyword.
+func isSCIMKeyword(tok scimToken, keyword string) bool {
+	return tok.kind == scimWord && strings.EqualFold(tok.text, keyword)
+}
+
+// or parses the filters joined by or, which binds looser than and. prefix is
+// the path of the value path being filtered, if any.
+func (sp *scimParser) or(prefix string) (Node, *Error) {
+	var terms []Node
+	for {
+		term, err := sp.and(prefix)
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+		if !isSCIMKeyword(sp.peek(), scimOr) {
+			return balancedChain(OperatorOr, terms), nil
+		}
+		sp.next()
+	}
+}
+
+// and parses the filters joined by and.
+func (sp *scimParser) and(prefix string) (Node, *Error) {
+	var terms []Node
+	for {
+		term, err := sp.filterTerm(prefix)
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+		if !isSCIMKeyword(sp.peek(), scimAnd) {
+			return balancedChain(OperatorAnd, terms), nil
+		}
+		sp.next()
+	}
+}
+
+// filterTerm parses a negated or parenthesized filter, a value path or an
+// attribute expression.
+func (sp *scimParser) filterTerm(prefix string) (Node, *Error) {
+	tok := sp.next()
+	switch {
+	case isSCIMKeyword(tok, scimNot):
+		if open := sp.next(); open.kind != scimLParen {
+			return nil, sp.errorAt(open, SyntaxErrorKind, "missing ( after %s", tok.text)
+		}
+		node, err := sp.group(prefix, scimRParen)
+		if err != nil {
+			return nil, err
+		}
+		return &NotExpr{Not: node}, nil
+	case tok.kind == scimLParen:
+		return sp.group(prefix, scimRParen)
+	case tok.kind == scimWord && !isSCIMKeyword(tok, scimAnd) && !isSCIMKeyword(tok, scimOr):
+		if open := sp.peek(); open.kind == scimLBracket && open.offset == tok.end {
+			if prefix != "" {
+				return nil, sp.errorAt(open, UnsupportedErrorKind, "unsupported nested value path")
+			}
+			sp.next()
+			return sp.group(scimAttribute(tok.text)+".", scimRBracket)
+		}
+		return sp.attributeExpression(prefix, tok)
+	default:
+		return nil, sp.errorAt(tok, SyntaxErrorKind, "unexpected %s", tok.text)
+	}
+}
+
+// group parses the filter enclosed up to the closing parenthesis or bracket.
+func (sp *scimParser) group(prefix string, closing scimTokenKind) (Node, *Error) {
+	node, err := sp.or(prefix)
+	if err != nil {
+		return nil, err
+	}
+	if tok := sp.next(); tok.kind != closing {
+		if closing == scimRParen {
+			return nil, sp.errorAt(tok, SyntaxErrorKind, "missing )")
+		}
+		return nil, sp.errorAt(tok, SyntaxErrorKind, "missing ]")
+	}
+	return node, nil
+}
+
+// attributeExpression parses the presence or comparison of the attribute.
+func (sp *scimParser) attributeExpression(prefix string, attrTok scimToken) (Node, *Error) {
+	field, err := sp.field(prefix+scimAttribute(attrTok.text), attrTok)
+	if err != nil {
+		return nil, err
+	}
+	opTok := sp.next()
+	if isSCIMKeyword(opTok, scimPresent) {
+		return sp.build(Present(field.Name), attrTok)
+	}
+	op, ok := scimComparisons[strings.ToLower(opTok.text)]
+	if opTok.kind != scimWord || !ok {
+		return nil, sp.errorAt(opTok, SyntaxErrorKind, "missing operator of %s", attrTok.text)
+	}
+
+	valueTok := sp.next()
+	value, err := scimValue(valueTok, field.Ftype)
+	if err != nil {
+		err.Field = field.Name
+		err.Operator = op
+		err.setSpan(sp.filter, valueTok.offset, valueTok.end)
+		return nil, err
+	}
+	// values of string arrays match any of their elements, which can't be
+	// matched by substrings
+	if s, ok := value.(string); ok && field.Ftype == StringArrayFieldType {
+		switch op {
+		case OperatorEquals:
+			return sp.build(Contains(field.Name, s), attrTok)
+		case OperatorNotEquals:
+			return sp.build(Not(Contains(field.Name, s)), attrTok)
+		case OperatorContains, OperatorStartsWith, OperatorEndsWith:
+			err := sp.errorAt(attrTok, TypeMismatchErrorKind, "%s is unsupported by multi-valued attribute %s", opTok.text, attrTok.text)
+			err.Field = field.Name
+			err.Operator = op
+			return nil, err
+		}
+	}
+	return sp.build(&opCond{field: field.Name, op: op, values: []any{value}}, attrTok)
+}
+
+// field returns the field of the attribute, matched case insensitively if
+// none is named exactly like it.
+func (sp *scimParser) field(name string, attrTok scimToken) (*Field, *Error) {
+	if field, ok := sp.parser.fields[name]; ok {
+		return field, nil
+	}
+	var match *Field
+	for fieldName, field := range sp.parser.fields {
+		if strings.EqualFold(fieldName, name) {
+			if match != nil {
+				err := sp.errorAt(attrTok, UnknownFieldErrorKind, "ambiguous field %s", name)
+				err.Field = name
+				return nil, err
+			}
+			match = field
+		}
+	}
+	if match == nil {
+		_, err := sp.parser.buildField(name)
+		err.setSpan(sp.filter, attrTok.offset, attrTok.end)
+		return nil, err
+	}
+	return match, nil
+}
+
+// build builds the condition, locating its errors at the attribute.
+func (sp *scimParser) build(cond Cond, attrTok scimToken) (Node, *Error) {
+	node, err := cond.build(sp.parser)
+	if err != nil {
+		err.setSpan(sp.filter, attrTok.offset, attrTok.end)
+		return nil, err
+	}
+	return node, nil
+}
+
+// scimAttribute returns the attribute path without its schema URN, if any,
+// such as emails.value for urn:ietf:params:scim:schemas:core:2.0:User:emails.value.
+func scimAttribute(path string) string {
+	if !strings.HasPrefix(strings.ToLower(path), "urn:") {
+		return path
+	}
+	return path[strings.LastIndexByte(path, ':')+1:]
+}
+
+// scimValue converts the value to the type of the args of fields of the given
+// type: strings to timestamps for timestamp fields, and numbers to integers
+// or doubles. Literals are case insensitive.
+func scimValue(tok scimToken, ftype FieldType) (any, *Error) {
+	switch {
+	case tok.kind == scimString && ftype == TimestampFieldType:
+		t, err := time.Parse(time.RFC3339, tok.text)
+		if err != nil {
+			return nil, builderError(InvalidValueErrorKind, "invalid %s value %s", ftype, tok.text)
+		}
+		return t.UTC(), nil
+	case tok.kind == scimString:
+		return tok.text, nil
+	case tok.kind != scimWord:
+		return nil, builderError(SyntaxErrorKind, "missing value before %s", tok.text)
+	case strings.EqualFold(tok.text, "null"):
+		return nil, nil
+	case strings.EqualFold(tok.text, "true") || strings.EqualFold(tok.text, "false"):
+		return strings.EqualFold(tok.text, "true"), nil
+	}
+	if n, err := strconv.ParseInt(tok.text, 10, 64); err == nil {
+		return n, nil
+	}
+	if f, err := strconv.ParseFloat(tok.text, 64); err == nil {
+		return f, nil
+	}
+	return nil, builderError(SyntaxErrorKind, "invalid value %s", tok.text)
+}
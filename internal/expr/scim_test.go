@@ -0,0 +1,128 @@
+package expr
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+func newSCIMTestParser(t *testing.T) *Parser {
+	parser, err := NewParser(map[string]*exprpb.Type{
+		"userName":          {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+		"name.familyName":   {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+		"emails.type":       {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+		"emails.value":      {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+		"employeeNumber":    {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_INT64}},
+		"score":             {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_DOUBLE}},
+		"active":            {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_BOOL}},
+		"meta.lastModified": {TypeKind: &exprpb.Type_WellKnown{WellKnown: exprpb.Type_TIMESTAMP}},
+		"groups": {TypeKind: &exprpb.Type_ListType_{ListType: &exprpb.Type_ListType{
+			ElemType: &exprpb.Type{TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+		}}},
+	})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	return parser
+}
+
+func TestParseSCIM(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "empty filter", input: " ", want: ""},
+		{name: "equals", input: `userName eq "bjensen"`, want: `userName == "bjensen"`},
+		{name: "escaped string", input: `userName eq "b\"jensené"`, want: `userName == "b\"jensené"`},
+		{name: "comparisons", input: "employeeNumber ne 1 and employeeNumber gt 2 and employeeNumber ge 3 and employeeNumber lt 4 and employeeNumber le 5", want: "employeeNumber != 1 && employeeNumber > 2 && employeeNumber >= 3 && employeeNumber < 4 && employeeNumber <= 5"},
+		{name: "string operators", input: `userName co "je" or userName sw "bj" or userName ew "en"`, want: `userName.contains("je") || userName.startsWith("bj") || userName.endsWith("en")`},
+		{name: "present", input: "meta.lastModified pr", want: "present(meta.lastModified)"},
+		{name: "case insensitive", input: `USERNAME EQ "bjensen" AND Active Eq True`, want: `userName == "bjensen" && active == true`},
+		{name: "negative number", input: "employeeNumber eq -1", want: "employeeNumber == -1"},
+		{name: "coerced integer", input: "score gt 10", want: "score > 10.0"},
+		{name: "double", input: "score lt 1.5", want: "score < 1.5"},
+		{name: "null", input: "meta.lastModified eq null", want: "meta.lastModified == null"},
+		{name: "timestamp", input: `meta.lastModified gt "2011-05-13T04:42:34Z"`, want: "meta.lastModified > timestamp('2011-05-13T04:42:34Z')"},
+		{name: "schema urn", input: `urn:ietf:params:scim:schemas:core:2.0:User:userName sw "J"`, want: `userName.startsWith("J")`},
+		{name: "array element", input: `groups eq "admins"`, want: `groups.contains("admins")`},
+		{name: "array element not equal", input: `groups ne "admins"`, want: `!groups.contains("admins")`},
+		{name: "precedence", input: `userName eq "a" or userName eq "b" and active eq true`, want: `userName == "a" || userName == "b" && active == true`},
+		{name: "parentheses", input: `(userName eq "a" or userName eq "b") and active eq true`, want: `(userName == "a" || userName == "b") && active == true`},
+		{name: "not", input: `not (userName eq "a" or active eq true)`, want: `!(userName == "a" || active == true)`},
+		{
+			name:  "value path",
+			input: `userName eq "bjensen" and emails[type eq "work" and value co "@example.com"]`,
+			want:  `userName == "bjensen" && (emails.type == "work" && emails.value.contains("@example.com"))`,
+		},
+	}
+
+	parser := newSCIMTestParser(t)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parser.ParseSCIM(tt.input)
+			if err != nil {
+				t.Fatalf("ParseSCIM() error: %v", err)
+			}
+			want, err := parser.Parse(tt.want)
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ParseSCIM() got: %s, want %s", formatted(got), formatted(want))
+			}
+		})
+	}
+}
+
+func TestParseSCIMErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		wantKind   ErrorKind
+		wantOffset int
+		wantEnd    int
+		wantField  string
+	}{
+		{name: "unknown field", input: `active eq true and usrName eq "a"`, wantKind: UnknownFieldErrorKind, wantOffset: 19, wantEnd: 26, wantField: "usrName"},
+		{name: "missing operator", input: "userName", wantKind: SyntaxErrorKind, wantOffset: 8, wantEnd: 8},
+		{name: "unknown operator", input: `userName like "a"`, wantKind: SyntaxErrorKind, wantOffset: 9, wantEnd: 13},
+		{name: "missing value", input: "userName eq", wantKind: SyntaxErrorKind, wantOffset: 11, wantEnd: 11, wantField: "userName"},
+		{name: "invalid value", input: "employeeNumber eq abc", wantKind: SyntaxErrorKind, wantOffset: 18, wantEnd: 21, wantField: "employeeNumber"},
+		{name: "invalid timestamp", input: `meta.lastModified gt "yesterday"`, wantKind: InvalidValueErrorKind, wantOffset: 21, wantEnd: 32, wantField: "meta.lastModified"},
+		{name: "type mismatch", input: `employeeNumber eq "1"`, wantKind: TypeMismatchErrorKind, wantOffset: 0, wantEnd: 14, wantField: "employeeNumber"},
+		{name: "unsupported operator", input: "active gt true", wantKind: TypeMismatchErrorKind, wantOffset: 0, wantEnd: 6, wantField: "active"},
+		{name: "contains of multi-valued attribute", input: `groups co "adm"`, wantKind: TypeMismatchErrorKind, wantOffset: 0, wantEnd: 6, wantField: "groups"},
+		{name: "prefix of multi-valued attribute", input: `groups sw "adm"`, wantKind: TypeMismatchErrorKind, wantOffset: 0, wantEnd: 6, wantField: "groups"},
+		{name: "not without parentheses", input: `not userName eq "a"`, wantKind: SyntaxErrorKind, wantOffset: 4, wantEnd: 12},
+		{name: "missing parenthesis", input: `(userName eq "a"`, wantKind: SyntaxErrorKind, wantOffset: 16, wantEnd: 16},
+		{name: "missing bracket", input: `emails[type eq "work"`, wantKind: SyntaxErrorKind, wantOffset: 21, wantEnd: 21},
+		{name: "nested value path", input: `emails[type[value eq "a"]]`, wantKind: UnsupportedErrorKind, wantOffset: 11, wantEnd: 12},
+		{name: "unterminated string", input: `userName eq "a`, wantKind: SyntaxErrorKind, wantOffset: 12, wantEnd: 14},
+	}
+
+	parser := newSCIMTestParser(t)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := parser.ParseSCIM(tt.input)
+			var exprErr *Error
+			if !errors.As(err, &exprErr) {
+				t.Fatalf("ParseSCIM() error: %v, want *Error", err)
+			}
+			if exprErr.Kind != tt.wantKind || exprErr.Offset != tt.wantOffset || exprErr.End != tt.wantEnd || exprErr.Field != tt.wantField {
+				t.Errorf("ParseSCIM() error: %v (kind %s, offset %d, end %d, field %q), want kind %s, offset %d, end %d, field %q",
+					exprErr, exprErr.Kind, exprErr.Offset, exprErr.End, exprErr.Field, tt.wantKind, tt.wantOffset, tt.wantEnd, tt.wantField)
+			}
+		})
+	}
+}
@@ -2,91 +2,190 @@ package expr
 
 import (
 	"fmt"
-	"regexp"
 	"strings"
 
 	"errors"
 )
 
+// SQLOpt sets options of the SQL generation, such as the dialect.
+type SQLOpt func(w *sqlWriter)
+
+// WithDialect sets the dialect the SQL clause is rendered for.
+func WithDialect(dialect Dialect) SQLOpt {
+	return func(w *sqlWriter) {
+		w.dialect = dialect
+	}
+}
+
 // SQL returns a database friendly format composed by a string clause and a
 // slice of args
-func SQL(expr *Expr) (string, []any, error) {
-	return walkSQL(expr.Root)
+func SQL(expr *Expr, opts ...SQLOpt) (string, []any, error) {
+	w := &sqlWriter{
+		dialect: DefaultDialect,
+		args:    []any{},
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	clause, err := w.walk(expr.Root)
+	if err != nil {
+		return "", nil, err
+	}
+	return clause, w.args, nil
+}
+
+// sqlWriter renders nodes as SQL, collecting the args bound to the
+// placeholders in order.
+type sqlWriter struct {
+	dialect Dialect
+	args    []any
+	// onWalk, if set, is called with the clause of every node walked.
+	onWalk func(node Node, clause string)
+}
+
+// bind adds an arg and returns its placeholder.
+func (w *sqlWriter) bind(arg any) string {
+	w.args = append(w.args, arg)
+	return w.dialect.Placeholder(len(w.args))
 }
 
 type sqlOperator struct {
 	name        string
-	argModifier func(any) any
+	argModifier func(any) (any, error)
+	// predicate renders the operation when name alone isn't enough, params
+	// are the placeholders of the args.
+	predicate func(d Dialect, column string, params []string) (string, error)
 }
 
 var sqlOperatorLookup = map[string]map[FieldType]*sqlOperator{
 	OperatorEquals: {
-		StringFieldType:  {name: "="},
-		IntegerFieldType: {name: "="},
-		BoolFieldType:    {name: "="},
+		StringFieldType:    {name: "="},
+		IntegerFieldType:   {name: "="},
+		BoolFieldType:      {name: "="},
+		DoubleFieldType:    {name: "="},
+		TimestampFieldType: {name: "="},
 	},
 	OperatorNotEquals: {
-		StringFieldType:  {name: "<>"}, // equivalent to != but SQL-92 compliant
-		IntegerFieldType: {name: "<>"}, // equivalent to != but SQL-92 compliant
+		StringFieldType:    {name: "<>"}, // equivalent to != but SQL-92 compliant
+		IntegerFieldType:   {name: "<>"}, // equivalent to != but SQL-92 compliant
+		DoubleFieldType:    {name: "<>"}, // equivalent to != but SQL-92 compliant
+		TimestampFieldType: {name: "<>"}, // equivalent to != but SQL-92 compliant
 	},
 	OperatorGreater: {
 		TimestampFieldType: {name: ">"},
 		IntegerFieldType:   {name: ">"},
+		DoubleFieldType:    {name: ">"},
 	},
 	OperatorGreaterEquals: {
 		TimestampFieldType: {name: ">="},
 		IntegerFieldType:   {name: ">="},
+		DoubleFieldType:    {name: ">="},
 	},
 	OperatorLess: {
 		TimestampFieldType: {name: "<"},
 		IntegerFieldType:   {name: "<"},
+		DoubleFieldType:    {name: "<"},
 	},
 	OperatorLessEquals: {
 		TimestampFieldType: {name: "<="},
 		IntegerFieldType:   {name: "<="},
+		DoubleFieldType:    {name: "<="},
 	},
 	OperatorIn: {
-		StringFieldType:  {name: "IN"},
-		IntegerFieldType: {name: "IN"},
+		StringFieldType:    {name: "IN"},
+		IntegerFieldType:   {name: "IN"},
+		DoubleFieldType:    {name: "IN"},
+		TimestampFieldType: {name: "IN"},
 	},
 	OperatorStartsWith: {
 		StringFieldType: {
 			name:        "LIKE",
-			argModifier: func(v any) any { return escapeLikeArg(v) + "%" },
+			argModifier: func(v any) (any, error) { return likeArg(v, "", "%") },
+			predicate:   likePredicate,
 		},
 	},
 	OperatorEndsWith: {
 		StringFieldType: {
 			name:        "LIKE",
-			argModifier: func(v any) any { return "%" + escapeLikeArg(v) },
+			argModifier: func(v any) (any, error) { return likeArg(v, "%", "") },
+			predicate:   likePredicate,
 		},
 	},
 	OperatorContains: {
 		StringFieldType: {
 			name:        "LIKE",
-			argModifier: func(v any) any { return "%" + escapeLikeArg(v) + "%" },
+			argModifier: func(v any) (any, error) { return likeArg(v, "%", "%") },
+			predicate:   likePredicate,
 		},
 		StringArrayFieldType: {
-			name:        "@>",
-			argModifier: func(v any) any { return "{" + escapeLikeArg(v) + "}" },
+			name: "CONTAINS",
+			predicate: func(d Dialect, column string, params []string) (string, error) {
+				if len(params) != 1 {
+					return "", errors.New("expr: contains requires a single arg")
+				}
+				return d.ArrayContains(column, params[0]), nil
+			},
 		},
 	},
 }
 
+func likePredicate(d Dialect, column string, params []string) (string, error) {
+	if len(params) != 1 {
+		return "", errors.New("expr: LIKE requires a single arg")
+	}
+	return d.Like(d.Lower(column), fmt.Sprintf("(%s)", d.Lower(params[0]))), nil
+}
+
+// likeEscaper escapes the LIKE wildcards of an arg. Backslash is used as the
+// escape character, thus any arg containing a backslash needs to be doubled
+// in order to be escaped correctly inside LIKE queries. Brackets are escaped
+// too, as SQL Server treats them as character ranges.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`, `[`, `\[`)
+
 // escapeLikeArg gets a SQL arg and returns its equivalent SQL-LIKE needed
-// escaped arg. In SQL-92, backslash is used as the escape character, thus any
-// arg containing a backslash needs to be doubled in order to be escaped
-// correctly inside LIKE queries.
-func escapeLikeArg(arg any) string {
-	return strings.ReplaceAll(arg.(string), `\`, `\\`)
+// escaped arg.
+func escapeLikeArg(arg any) (string, error) {
+	s, ok := arg.(string)
+	if !ok {
+		return "", fmt.Errorf("expr: unsupported LIKE arg of type %T", arg)
+	}
+	return likeEscaper.Replace(s), nil
+}
+
+// likeArg returns the escaped arg surrounded by the LIKE wildcards given.
+func likeArg(arg any, prefix, suffix string) (any, error) {
+	escaped, err := escapeLikeArg(arg)
+	if err != nil {
+		return nil, err
+	}
+	return prefix + escaped + suffix, nil
+}
+
+func (w *sqlWriter) walk(node Node) (string, error) {
+	clause, err := w.walkNode(node)
+	if err != nil {
+		return "", err
+	}
+	if w.onWalk != nil {
+		w.onWalk(node, clause)
+	}
+	return clause, nil
 }
 
-func walkSQL(node Node) (string, []any, error) {
+func (w *sqlWriter) walkNode(node Node) (string, error) {
 	switch e := node.(type) {
 	case *NotExpr:
-		clause, args, err := walkSQL(e.Not)
+		// Shortcut !present(x) as x IS NULL, instead of NOT (x IS NOT NULL).
+		if presentExpr, ok := e.Not.(*PresentExpr); ok {
+			columnName, err := w.column(presentExpr.Field, 0)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("%s IS NULL", columnName), nil
+		}
+		clause, err := w.walk(e.Not)
 		if err != nil {
-			return "", nil, err
+			return "", err
 		}
 		// Although it seems countersqlintuitive, these kind of queries do the
 		// same if the NOT is used just before the IN operation or embracing
@@ -108,59 +207,77 @@ func walkSQL(node Node) (string, []any, error) {
 		// 	| table  | users@primary
 		// 	| spans  | ALL
 		// 	| filter | name_id NOT IN ('burt-warren',)
-		return fmt.Sprintf("NOT (%s)", clause), args, nil
+		return fmt.Sprintf("NOT (%s)", clause), nil
 	case *AndExpr:
-		lclause, largs, err := walkSQL(e.Left)
+		lclause, err := w.walk(e.Left)
 		if err != nil {
-			return "", nil, err
+			return "", err
 		}
-		rclause, rargs, err := walkSQL(e.Right)
+		rclause, err := w.walk(e.Right)
 		if err != nil {
-			return "", nil, err
+			return "", err
 		}
-		return fmt.Sprintf("(%s AND %s)", lclause, rclause), append(largs, rargs...), nil
+		return fmt.Sprintf("(%s AND %s)", lclause, rclause), nil
 	case *OrExpr:
-		lclause, largs, err := walkSQL(e.Left)
+		lclause, err := w.walk(e.Left)
 		if err != nil {
-			return "", nil, err
+			return "", err
 		}
-		rclause, rargs, err := walkSQL(e.Right)
+		rclause, err := w.walk(e.Right)
 		if err != nil {
-			return "", nil, err
+			return "", err
 		}
-		return fmt.Sprintf("(%s OR %s)", lclause, rclause), append(largs, rargs...), nil
+		return fmt.Sprintf("(%s OR %s)", lclause, rclause), nil
 	case *OpExpr:
+		// in takes a list of args, IN () being invalid, and the rest a single one
+		if len(e.Args) == 0 || e.Op != OperatorIn && len(e.Args) != 1 {
+			return "", fmt.Errorf("expr: invalid number of args of %s", e.Op)
+		}
+
+		// Comparisons against null never match in SQL, render them as
+		// IS NULL or IS NOT NULL instead.
+		if isNullComparison(e) {
+			return w.nullComparison(e)
+		}
 		switch kind := e.Left.(type) {
 		case *SizeExpr:
-			lclause, _, err := walkSQL(e.Left)
+			lclause, err := w.walk(e.Left)
 			if err != nil {
-				return "", nil, err
+				return "", err
 			}
 			sqlOp, ok := sqlOperatorLookup[e.Op][IntegerFieldType]
 			if !ok {
-				return "", nil, errors.New("expr: unsupported operation expression")
+				return "", errors.New("expr: unsupported operation expression")
+			}
+			params := make([]string, len(e.Args))
+			for i, arg := range e.Args {
+				params[i] = w.bind(arg)
 			}
-			parameters := strings.TrimRight(strings.Repeat("?,", len(e.Args)), ",")
-			return fmt.Sprintf("%s %s %s", lclause, sqlOp.name, parameters), e.Args, nil
+			// Enclosed with parentheses as field comparisons are, which IN
+			// requires.
+			return fmt.Sprintf("%s %s (%s)", lclause, sqlOp.name, strings.Join(params, ",")), nil
 		case *Field:
 			sqlOp, ok := sqlOperatorLookup[e.Op][kind.Ftype]
 			if !ok {
-				return "", nil, errors.New("expr: unsupported operation expression")
+				return "", errors.New("expr: unsupported operation expression")
 			}
 
-			var args []any
-			if sqlOp.argModifier != nil {
-				args = make([]any, len(e.Args))
-				for i := 0; i < len(e.Args); i++ {
-					args[i] = sqlOp.argModifier(e.Args[i])
-				}
-			} else {
-				args = e.Args
+			columnName, err := w.column(kind, len(e.Args))
+			if err != nil {
+				return "", err
 			}
 
-			columnName, err := columnName(kind.Name, kind.Ftype, len(args))
-			if err != nil {
-				return "", nil, err
+			params := make([]string, len(e.Args))
+			for i, arg := range e.Args {
+				if sqlOp.argModifier != nil {
+					if arg, err = sqlOp.argModifier(arg); err != nil {
+						return "", err
+					}
+				}
+				params[i] = w.bind(arg)
+			}
+			if sqlOp.predicate != nil {
+				return sqlOp.predicate(w.dialect, columnName, params)
 			}
 
 			// As this SQL is supported SELECT ... WHERE name_id = ('burt-warren'),
@@ -169,58 +286,94 @@ func walkSQL(node Node) (string, []any, error) {
 			// Enclose field and args with LOWER() in case of string query for a
 			// case insensitive query.
 			case StringFieldType:
-				parameters := fmt.Sprintf("(%s)", strings.TrimRight(strings.Repeat("LOWER(?),", len(args)), ","))
-				return fmt.Sprintf("LOWER(%s) %s %s", columnName, sqlOp.name, parameters), args, nil
+				if e.CaseSensitive {
+					return fmt.Sprintf("%s %s (%s)", columnName, sqlOp.name, strings.Join(params, ",")), nil
+				}
+				for i := range params {
+					params[i] = w.dialect.Lower(params[i])
+				}
+				return fmt.Sprintf("%s %s (%s)", w.dialect.Lower(columnName), sqlOp.name, strings.Join(params, ",")), nil
 			default:
-				parameters := fmt.Sprintf("(%s)", strings.TrimRight(strings.Repeat("?,", len(args)), ","))
-				return fmt.Sprintf("%s %s %s", columnName, sqlOp.name, parameters), args, nil
+				return fmt.Sprintf("%s %s (%s)", columnName, sqlOp.name, strings.Join(params, ",")), nil
 			}
 		default:
-			return "", nil, errors.New("expr: unsupported operation expression")
+			return "", errors.New("expr: unsupported operation expression")
 
 		}
+	case *Field:
+		if e.Ftype != BoolFieldType {
+			return "", fmt.Errorf("expr: unsupported non boolean field %s as predicate", e.Name)
+		}
+		columnName, err := w.column(e, 0)
+		if err != nil {
+			return "", err
+		}
+		return w.dialect.IsTrue(columnName), nil
 	case *PresentExpr:
-		columnName, err := columnName(e.Field.Name, e.Field.Ftype, 0)
+		columnName, err := w.column(e.Field, 0)
 		if err != nil {
-			return "", nil, err
+			return "", err
 		}
-		return fmt.Sprintf("%s IS NOT NULL", columnName), []any{}, nil
+		return fmt.Sprintf("%s IS NOT NULL", columnName), nil
 	case *SizeExpr:
-		return fmt.Sprintf("json_array_length(%s)", e.Field.Name), []any{}, nil
+		columnName, err := w.column(e.Field, 0)
+		if err != nil {
+			return "", err
+		}
+		return w.dialect.ArrayLength(columnName), nil
 	default:
-		return "", nil, errors.New("expr: unsupported expression")
+		return "", errors.New("expr: unsupported expression")
 	}
 }
 
-// This regex is used to obtain the values before and after the last operator "->"
-// example: "apple_hub->apple_key->owner_name" would be:
-// - $1 := "apple_hub->apple_key"
-// - $2 := "owner_name"
-var re = regexp.MustCompile(`(.*)->(\w+)$`)
+// isNullComparison returns true if the operation checks for equality or
+// inequality against null.
+func isNullComparison(e *OpExpr) bool {
+	return (e.Op == OperatorEquals || e.Op == OperatorNotEquals) && len(e.Args) == 1 && e.Args[0] == nil
+}
+
+func (w *sqlWriter) nullComparison(e *OpExpr) (string, error) {
+	var lclause string
+	var err error
+	switch kind := e.Left.(type) {
+	case *Field:
+		lclause, err = w.column(kind, 0)
+	case *SizeExpr:
+		lclause, err = w.walk(kind)
+	default:
+		err = errors.New("expr: unsupported operation expression")
+	}
+	if err != nil {
+		return "", err
+	}
+	if e.Op == OperatorNotEquals {
+		return fmt.Sprintf("%s IS NOT NULL", lclause), nil
+	}
+	return fmt.Sprintf("%s IS NULL", lclause), nil
+}
+
+// column returns the SQL expression of the given field, with its column
+// identifier quoted. Fields mapped to a JSON path are cast to the field type.
+func (w *sqlWriter) column(field *Field, numArgs int) (string, error) {
+	column, err := fieldColumn(field)
+	if err != nil {
+		return "", err
+	}
+	parts := make([]string, len(column.Name))
+	for i, part := range column.Name {
+		parts[i] = w.dialect.QuoteIdentifier(part)
+	}
+	identifier := strings.Join(parts, ".")
 
-// Using the regex above, we can transform the string to allow nested searches.
-// example: "apple_hub.apple_key.owner_name" would be:
-// - "apple_hub->apple_key->>'owner_name'"
-func columnName(fieldName string, fieldType FieldType, numArgs int) (string, error) {
 	// nested equality logic
-	if strings.Contains(fieldName, ".") {
-		if numArgs > 1 {
-			return "", fmt.Errorf("expr: unsupported multiple args for nested type")
-		}
-		fieldName = strings.ReplaceAll(fieldName, ".", "->")
-		fieldName = re.ReplaceAllString(fieldName, `$1->>'$2'`)
-		switch fieldType {
-		case BoolFieldType:
-			fieldName = fmt.Sprintf("(%s)::BOOL", fieldName)
-		case IntegerFieldType:
-			fieldName = fmt.Sprintf("(%s)::INT", fieldName)
-		case DoubleFieldType:
-			fieldName = fmt.Sprintf("(%s)::FLOAT", fieldName)
-		case BytesFieldType:
-			fieldName = fmt.Sprintf("(%s)::BYTES", fieldName)
-		case TimestampFieldType:
-			fieldName = fmt.Sprintf("(%s)::TIMESTAMP", fieldName)
-		}
+	if len(column.Path) == 0 {
+		return identifier, nil
+	}
+	if numArgs > 1 {
+		return "", fmt.Errorf("expr: unsupported multiple args for nested type")
+	}
+	if field.Ftype == StringArrayFieldType {
+		return "", errors.New("expr: unsupported nested array field")
 	}
-	return fieldName, nil
+	return w.dialect.Cast(w.dialect.JSONPath(identifier, column.Path), field.Ftype), nil
 }
@@ -1,225 +1,448 @@
 package expr
 
 import (
-	"errors"
-	"fmt"
-	"regexp"
-	"strings"
+	"reflect"
+	"testing"
+
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 )
 
-// SQL returns a database friendly format composed by a string clause and a
-// slice of args
-func SQL(expr *Expr) (string, []any, error) {
-	return walkSQL(expr.Root)
+func newSQLTestParser(t *testing.T) *Parser {
+	parser, err := NewParser(map[string]*exprpb.Type{
+		"first_name":            {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+		"company.name":          {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+		"company.location.zone": {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_INT64}},
+		"company.fortune500":    {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_BOOL}},
+		"age":                   {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_INT64}},
+		"price":                 {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_DOUBLE}},
+		"active":                {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_BOOL}},
+		"birth_date":            {TypeKind: &exprpb.Type_WellKnown{WellKnown: exprpb.Type_TIMESTAMP}},
+		"tags": {TypeKind: &exprpb.Type_ListType_{ListType: &exprpb.Type_ListType{
+			ElemType: &exprpb.Type{TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+		}}},
+	})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	return parser
 }
 
-type sqlOperator struct {
-	name        string
-	argModifier func(any) any
-}
+func TestSQL(t *testing.T) {
+	t.Parallel()
 
-var sqlOperatorLookup = map[string]map[FieldType]*sqlOperator{
-	OperatorEquals: {
-		StringFieldType:  {name: "="},
-		IntegerFieldType: {name: "="},
-		BoolFieldType:    {name: "="},
-	},
-	OperatorNotEquals: {
-		StringFieldType:  {name: "<>"}, // equivalent to != but SQL-92 compliant
-		IntegerFieldType: {name: "<>"}, // equivalent to != but SQL-92 compliant
-	},
-	OperatorGreater: {
-		TimestampFieldType: {name: ">"},
-		IntegerFieldType:   {name: ">"},
-	},
-	OperatorGreaterEquals: {
-		TimestampFieldType: {name: ">="},
-		IntegerFieldType:   {name: ">="},
-	},
-	OperatorLess: {
-		TimestampFieldType: {name: "<"},
-		IntegerFieldType:   {name: "<"},
-	},
-	OperatorLessEquals: {
-		TimestampFieldType: {name: "<="},
-		IntegerFieldType:   {name: "<="},
-	},
-	OperatorIn: {
-		StringFieldType:  {name: "IN"},
-		IntegerFieldType: {name: "IN"},
-	},
-	OperatorStartsWith: {
-		StringFieldType: {
-			name:        "LIKE",
-			argModifier: func(v any) any { return escapeLikeArg(v) + "%" },
-		},
-	},
-	OperatorEndsWith: {
-		StringFieldType: {
-			name:        "LIKE",
-			argModifier: func(v any) any { return "%" + escapeLikeArg(v) },
-		},
-	},
-	OperatorContains: {
-		StringFieldType: {
-			name:        "LIKE",
-			argModifier: func(v any) any { return "%" + escapeLikeArg(v) + "%" },
-		},
-		StringArrayFieldType: {
-			name:        "@>",
-			argModifier: func(v any) any { return "{" + escapeLikeArg(v) + "}" },
-		},
-	},
-}
+	tests := []struct {
+		name       string
+		input      string
+		wantClause string
+		wantArgs   []any
+		wantErr    bool
+	}{
+		{
+			name:       "equality",
+			input:      "first_name == 'A'",
+			wantClause: `LOWER("first_name") = (LOWER($1))`,
+			wantArgs:   []any{"A"},
+		},
+		{
+			name:       "equality with int value",
+			input:      "age == 35",
+			wantClause: `"age" = ($1)`,
+			wantArgs:   []any{int64(35)},
+		},
+		{
+			name:       "equality with nested value",
+			input:      "company.name == 'A'",
+			wantClause: `LOWER("company"->>'name') = (LOWER($1))`,
+			wantArgs:   []any{"A"},
+		},
+		{
+			name:       "equality with nested int value of depth 2",
+			input:      "company.location.zone == 1",
+			wantClause: `("company"->'location'->>'zone')::INT = ($1)`,
+			wantArgs:   []any{int64(1)},
+		},
+		{
+			name:       "equality with nested bool value",
+			input:      "company.fortune500 == true",
+			wantClause: `("company"->>'fortune500')::BOOL = ($1)`,
+			wantArgs:   []any{true},
+		},
+		{
+			name:       "not equals",
+			input:      "first_name != 'A'",
+			wantClause: `LOWER("first_name") <> (LOWER($1))`,
+			wantArgs:   []any{"A"},
+		},
+		{
+			name:       "not",
+			input:      "!(age == 35)",
+			wantClause: `NOT ("age" = ($1))`,
+			wantArgs:   []any{int64(35)},
+		},
+		{
+			name:       "and/or",
+			input:      "first_name == 'A' && (age == 1 || age == 2)",
+			wantClause: `(LOWER("first_name") = (LOWER($1)) AND ("age" = ($2) OR "age" = ($3)))`,
+			wantArgs:   []any{"A", int64(1), int64(2)},
+		},
+		{
+			name:       "greater than with double value",
+			input:      "price > 10",
+			wantClause: `"price" > ($1)`,
+			wantArgs:   []any{float64(10)},
+		},
+		{
+			name:       "in with double values",
+			input:      "price in [1.5, 2.0]",
+			wantClause: `"price" IN ($1,$2)`,
+			wantArgs:   []any{1.5, 2.0},
+		},
+		{
+			name:       "in",
+			input:      "age in [2, 15, 35]",
+			wantClause: `"age" IN ($1,$2,$3)`,
+			wantArgs:   []any{int64(2), int64(15), int64(35)},
+		},
+		{
+			name:    "disallow in with nested value",
+			input:   "company.name in ['A', 'B']",
+			wantErr: true,
+		},
+		{
+			name:       "startsWith",
+			input:      `first_name.startsWith('A\\_%')`,
+			wantClause: `LOWER("first_name") LIKE (LOWER($1)) ESCAPE E'\\'`,
+			wantArgs:   []any{`A\\\_\%%`},
+		},
+		{
+			name:       "endsWith",
+			input:      "first_name.endsWith('A')",
+			wantClause: `LOWER("first_name") LIKE (LOWER($1)) ESCAPE E'\\'`,
+			wantArgs:   []any{"%A"},
+		},
+		{
+			name:       "contains",
+			input:      "first_name.contains('A')",
+			wantClause: `LOWER("first_name") LIKE (LOWER($1)) ESCAPE E'\\'`,
+			wantArgs:   []any{"%A%"},
+		},
+		{
+			name:       "contains with string array field",
+			input:      "tags.contains('A')",
+			wantClause: `"tags" @> ARRAY[$1]`,
+			wantArgs:   []any{"A"},
+		},
+		{
+			name:       "greater than with timestamp value",
+			input:      `birth_date > timestamp("1983-12-10T11:03:27Z")`,
+			wantClause: `"birth_date" > ($1)`,
+			wantArgs:   []any{mustParseTimestamp(t, "1983-12-10T11:03:27Z")},
+		},
+		{
+			name:       "equality with timestamp value",
+			input:      `birth_date == timestamp("1983-12-10T11:03:27Z")`,
+			wantClause: `"birth_date" = ($1)`,
+			wantArgs:   []any{mustParseTimestamp(t, "1983-12-10T11:03:27Z")},
+		},
+		{
+			name:       "not equals with timestamp value",
+			input:      `birth_date != timestamp("1983-12-10T11:03:27Z")`,
+			wantClause: `"birth_date" <> ($1)`,
+			wantArgs:   []any{mustParseTimestamp(t, "1983-12-10T11:03:27Z")},
+		},
+		{
+			name:       "in with timestamp values",
+			input:      `birth_date in [timestamp("1983-12-10T11:03:27Z"), timestamp("2024-01-01T00:00:00Z")]`,
+			wantClause: `"birth_date" IN ($1,$2)`,
+			wantArgs:   []any{mustParseTimestamp(t, "1983-12-10T11:03:27Z"), mustParseTimestamp(t, "2024-01-01T00:00:00Z")},
+		},
+		{
+			name:       "present",
+			input:      "present(company.name)",
+			wantClause: `"company"->>'name' IS NOT NULL`,
+			wantArgs:   []any{},
+		},
+		{
+			name:       "not present",
+			input:      "!present(company.name)",
+			wantClause: `"company"->>'name' IS NULL`,
+			wantArgs:   []any{},
+		},
+		{
+			name:       "equality with null",
+			input:      "first_name == null",
+			wantClause: `"first_name" IS NULL`,
+			wantArgs:   []any{},
+		},
+		{
+			name:       "not equals with null",
+			input:      "company.location.zone != null",
+			wantClause: `("company"->'location'->>'zone')::INT IS NOT NULL`,
+			wantArgs:   []any{},
+		},
+		{
+			name:       "not equals with null within other clauses",
+			input:      "age == 1 && !(birth_date == null)",
+			wantClause: `("age" = ($1) AND NOT ("birth_date" IS NULL))`,
+			wantArgs:   []any{int64(1)},
+		},
+		{
+			name:       "bool field",
+			input:      "!active && company.fortune500",
+			wantClause: `(NOT ("active") AND ("company"->>'fortune500')::BOOL)`,
+			wantArgs:   []any{},
+		},
+		{
+			name:       "size",
+			input:      "size(tags) > 1",
+			wantClause: `COALESCE(array_length("tags", 1), 0) > ($1)`,
+			wantArgs:   []any{int64(1)},
+		},
+	}
 
-// escapeLikeArg gets a SQL arg and returns its equivalent SQL-LIKE needed
-// escaped arg. In SQL-92, backslash is used as the escape character, thus any
-// arg containing a backslash needs to be doubled in order to be escaped
-// correctly inside LIKE queries.
-func escapeLikeArg(arg any) string {
-	return strings.ReplaceAll(arg.(string), `\`, `\\`)
+	parser := newSQLTestParser(t)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			gotExpr, err := parser.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			gotClause, gotArgs, err := SQL(gotExpr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SQL() error: %v, wantErr %v", err, tt.wantErr)
+			}
+			if gotClause != tt.wantClause {
+				t.Errorf("SQL() got clause: %v, want %v", gotClause, tt.wantClause)
+			}
+			if !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+				t.Errorf("SQL() got args: %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
 }
 
-func walkSQL(node Node) (string, []any, error) {
-	switch e := node.(type) {
-	case *NotExpr:
-		clause, args, err := walkSQL(e.Not)
-		if err != nil {
-			return "", nil, err
-		}
-		// Although it seems countersqlintuitive, these kind of queries do the
-		// same if the NOT is used just before the IN operation or embracing
-		// everything including the ident as the query planner correctly chooses
-		// what to do:
-		//
-		// sql> EXPLAIN SELECT * FROM users WHERE NOT (name_id IN ('burt-warren'));
-		// tree | field  |           description
-		// +------+--------+---------------------------------+
-		// scan |        |
-		// 	| table  | users@primary
-		// 	| spans  | ALL
-		// 	| filter | name_id NOT IN ('burt-warren',)
-		//
-		// sql> EXPLAIN SELECT * FROM users WHERE name_id NOT IN ('burt-warren');
-		// tree | field  |           description
-		// +------+--------+---------------------------------+
-		// scan |        |
-		// 	| table  | users@primary
-		// 	| spans  | ALL
-		// 	| filter | name_id NOT IN ('burt-warren',)
-		return fmt.Sprintf("NOT (%s)", clause), args, nil
-	case *AndExpr:
-		lclause, largs, err := walkSQL(e.Left)
-		if err != nil {
-			return "", nil, err
-		}
-		rclause, rargs, err := walkSQL(e.Right)
-		if err != nil {
-			return "", nil, err
-		}
-		return fmt.Sprintf("(%s AND %s)", lclause, rclause), append(largs, rargs...), nil
-	case *OrExpr:
-		lclause, largs, err := walkSQL(e.Left)
-		if err != nil {
-			return "", nil, err
-		}
-		rclause, rargs, err := walkSQL(e.Right)
-		if err != nil {
-			return "", nil, err
-		}
-		return fmt.Sprintf("(%s OR %s)", lclause, rclause), append(largs, rargs...), nil
-	case *OpExpr:
-		switch kind := e.Left.(type) {
-		case *SizeExpr:
-			lclause, _, err := walkSQL(e.Left)
+func TestSQLDialects(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		dialect    Dialect
+		input      string
+		wantClause string
+	}{
+		{
+			name:       "postgresql",
+			dialect:    PostgreSQL,
+			input:      "first_name.startsWith('A') && company.location.zone == 1 && tags.contains('B') && size(tags) > 1",
+			wantClause: `((LOWER("first_name") LIKE (LOWER($1)) ESCAPE E'\\' AND ("company"->'location'->>'zone')::BIGINT = ($2)) AND ("tags" @> ARRAY[$3] AND cardinality("tags") > ($4)))`,
+		},
+		{
+			name:       "mysql",
+			dialect:    MySQL,
+			input:      "first_name.startsWith('A') && company.location.zone == 1 && tags.contains('B') && size(tags) > 1",
+			wantClause: "((LOWER(`first_name`) LIKE (LOWER(?)) ESCAPE CHAR(92) AND CAST(JSON_UNQUOTE(JSON_EXTRACT(`company`, '$.location.zone')) AS SIGNED) = (?)) AND (JSON_CONTAINS(`tags`, JSON_QUOTE(?)) AND JSON_LENGTH(`tags`) > (?)))",
+		},
+		{
+			name:       "mysql nested bool",
+			dialect:    MySQL,
+			input:      "company.fortune500 == true",
+			wantClause: "(JSON_UNQUOTE(JSON_EXTRACT(`company`, '$.fortune500')) = 'true') = (?)",
+		},
+		{
+			name:       "sqlite",
+			dialect:    SQLite,
+			input:      "first_name.startsWith('A') && company.location.zone == 1 && tags.contains('B') && size(tags) > 1",
+			wantClause: `((LOWER("first_name") LIKE (LOWER(?)) ESCAPE '\' AND CAST(json_extract("company", '$.location.zone') AS INTEGER) = (?)) AND (EXISTS (SELECT 1 FROM json_each("tags") WHERE value = ?) AND json_array_length("tags") > (?)))`,
+		},
+		{
+			name:       "sqlserver",
+			dialect:    SQLServer,
+			input:      "first_name.startsWith('A') && company.location.zone == 1 && tags.contains('B') && size(tags) > 1",
+			wantClause: `((LOWER([first_name]) LIKE (LOWER(@p1)) ESCAPE '\' AND CAST(JSON_VALUE([company], '$.location.zone') AS BIGINT) = (@p2)) AND (EXISTS (SELECT 1 FROM OPENJSON([tags]) WHERE value = @p3) AND (SELECT COUNT(*) FROM OPENJSON([tags])) > (@p4)))`,
+		},
+		{
+			name:       "mysql bool field",
+			dialect:    MySQL,
+			input:      "active",
+			wantClause: "`active` = TRUE",
+		},
+		{
+			name:       "sqlserver bool field",
+			dialect:    SQLServer,
+			input:      "!active",
+			wantClause: "NOT ([active] = 1)",
+		},
+		{
+			name:       "postgresql size in list",
+			dialect:    PostgreSQL,
+			input:      "size(tags) in [1, 2]",
+			wantClause: `cardinality("tags") IN ($1,$2)`,
+		},
+		{
+			name:       "mysql size in list",
+			dialect:    MySQL,
+			input:      "size(tags) in [1, 2]",
+			wantClause: "JSON_LENGTH(`tags`) IN (?,?)",
+		},
+		{
+			name:       "sqlite size in list",
+			dialect:    SQLite,
+			input:      "size(tags) in [1, 2]",
+			wantClause: `json_array_length("tags") IN (?,?)`,
+		},
+		{
+			name:       "sqlserver size in list",
+			dialect:    SQLServer,
+			input:      "size(tags) in [1, 2]",
+			wantClause: `(SELECT COUNT(*) FROM OPENJSON([tags])) IN (@p1,@p2)`,
+		},
+		{
+			name:       "cockroachdb size in list",
+			dialect:    CockroachDB,
+			input:      "size(tags) in [1, 2]",
+			wantClause: `COALESCE(array_length("tags", 1), 0) IN ($1,$2)`,
+		},
+		{
+			name:       "cockroachdb",
+			dialect:    CockroachDB,
+			input:      "first_name.startsWith('A') && company.location.zone == 1 && tags.contains('B') && size(tags) > 1",
+			wantClause: `((LOWER("first_name") LIKE (LOWER($1)) ESCAPE E'\\' AND ("company"->'location'->>'zone')::INT = ($2)) AND ("tags" @> ARRAY[$3] AND COALESCE(array_length("tags", 1), 0) > ($4)))`,
+		},
+	}
+
+	parser := newSQLTestParser(t)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			gotExpr, err := parser.Parse(tt.input)
 			if err != nil {
-				return "", nil, err
+				t.Fatalf("Parse() error: %v", err)
 			}
-			sqlOp, ok := sqlOperatorLookup[e.Op][IntegerFieldType]
-			if !ok {
-				return "", nil, errors.New("expr: unsupported operation expression")
+			gotClause, _, err := SQL(gotExpr, WithDialect(tt.dialect))
+			if err != nil {
+				t.Errorf("SQL() error: %v", err)
 			}
-			parameters := strings.TrimRight(strings.Repeat("?,", len(e.Args)), ",")
-			return fmt.Sprintf("%s %s %s", lclause, sqlOp.name, parameters), e.Args, nil
-		case *Field:
-			sqlOp, ok := sqlOperatorLookup[e.Op][kind.Ftype]
-			if !ok {
-				return "", nil, errors.New("expr: unsupported operation expression")
+			if gotClause != tt.wantClause {
+				t.Errorf("SQL() got clause: %v, want %v", gotClause, tt.wantClause)
 			}
+		})
+	}
+}
 
-			var args []any
-			if sqlOp.argModifier != nil {
-				args = make([]any, len(e.Args))
-				for i := 0; i < len(e.Args); i++ {
-					args[i] = sqlOp.argModifier(e.Args[i])
-				}
-			} else {
-				args = e.Args
-			}
+func TestSQLLikeEscape(t *testing.T) {
+	t.Parallel()
 
-			columnName, err := columnName(kind.Name, kind.Ftype, len(args))
+	tests := []struct {
+		name       string
+		dialect    Dialect
+		wantClause string
+	}{
+		{name: "postgresql", dialect: PostgreSQL, wantClause: `LOWER("first_name") LIKE (LOWER($1)) ESCAPE E'\\'`},
+		{name: "mysql", dialect: MySQL, wantClause: "LOWER(`first_name`) LIKE (LOWER(?)) ESCAPE CHAR(92)"},
+		{name: "sqlite", dialect: SQLite, wantClause: `LOWER("first_name") LIKE (LOWER(?)) ESCAPE '\'`},
+		{name: "sqlserver", dialect: SQLServer, wantClause: `LOWER([first_name]) LIKE (LOWER(@p1)) ESCAPE '\'`},
+		{name: "cockroachdb", dialect: CockroachDB, wantClause: `LOWER("first_name") LIKE (LOWER($1)) ESCAPE E'\\'`},
+	}
+
+	// the wildcards of the value are escaped, so they match literally
+	parser := newSQLTestParser(t)
+	gotExpr, err := parser.Parse(`first_name.contains('50%_off\\[a]')`)
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	wantArgs := []any{`%50\%\_off\\\[a]%`}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			gotClause, gotArgs, err := SQL(gotExpr, WithDialect(tt.dialect))
 			if err != nil {
-				return "", nil, err
+				t.Fatalf("SQL() error: %v", err)
 			}
-
-			// As this SQL is supported SELECT ... WHERE name_id = ('burt-warren'),
-			// we choose to always embrace with parentheses.
-			switch kind.Ftype {
-			// Enclose field and args with LOWER() in case of string query for a
-			// case insensitive query.
-			case StringFieldType:
-				parameters := fmt.Sprintf("(%s)", strings.TrimRight(strings.Repeat("LOWER(?),", len(args)), ","))
-				return fmt.Sprintf("LOWER(%s) %s %s", columnName, sqlOp.name, parameters), args, nil
-			default:
-				parameters := fmt.Sprintf("(%s)", strings.TrimRight(strings.Repeat("?,", len(args)), ","))
-				return fmt.Sprintf("%s %s %s", columnName, sqlOp.name, parameters), args, nil
+			if gotClause != tt.wantClause {
+				t.Errorf("SQL() got clause: %v, want %v", gotClause, tt.wantClause)
 			}
-		default:
-			return "", nil, errors.New("expr: unsupported operation expression")
-
-		}
-	case *PresentExpr:
-		columnName, err := columnName(e.Field.Name, e.Field.Ftype, 0)
-		if err != nil {
-			return "", nil, err
-		}
-		return fmt.Sprintf("%s IS NOT NULL", columnName), []any{}, nil
-	case *SizeExpr:
-		return fmt.Sprintf("json_array_length(%s)", e.Field.Name), []any{}, nil
-	default:
-		return "", nil, errors.New("expr: unsupported expression")
+			if !reflect.DeepEqual(gotArgs, wantArgs) {
+				t.Errorf("SQL() got args: %v, want %v", gotArgs, wantArgs)
+			}
+		})
 	}
 }
 
-// This regex is used to obtain the values before and after the last operator "->"
-// example: "apple_hub->apple_key->owner_name" would be:
-// - $1 := "apple_hub->apple_key"
-// - $2 := "owner_name"
-var re = regexp.MustCompile(`(.*)->(\w+)$`)
-
-// Using the regex above, we can transform the string to allow nested searches.
-// example: "apple_hub.apple_key.owner_name" would be:
-// - "apple_hub->apple_key->>'owner_name'"
-func columnName(fieldName string, fieldType FieldType, numArgs int) (string, error) {
-	// nested equality logic
-	if strings.Contains(fieldName, ".") {
-		if numArgs > 1 {
-			return "", fmt.Errorf("expr: unsupported multiple args for nested type")
-		}
-		fieldName = strings.ReplaceAll(fieldName, ".", "->")
-		fieldName = re.ReplaceAllString(fieldName, `$1->>'$2'`)
-		switch fieldType {
-		case BoolFieldType:
-			fieldName = fmt.Sprintf("(%s)::BOOL", fieldName)
-		case IntegerFieldType:
-			fieldName = fmt.Sprintf("(%s)::INT", fieldName)
-		case DoubleFieldType:
-			fieldName = fmt.Sprintf("(%s)::FLOAT", fieldName)
-		case BytesFieldType:
-			fieldName = fmt.Sprintf("(%s)::BYTES", fieldName)
-		case TimestampFieldType:
-			fieldName = fmt.Sprintf("(%s)::TIMESTAMP", fieldName)
-		}
+func TestSQLColumns(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		dialect    Dialect
+		input      string
+		wantClause string
+	}{
+		{
+			name:       "qualified column",
+			dialect:    PostgreSQL,
+			input:      "name == 'A'",
+			wantClause: `LOWER("u"."display_name") = (LOWER($1))`,
+		},
+		{
+			name:       "quoted column",
+			dialect:    MySQL,
+			input:      "nickname == 'A'",
+			wantClause: "LOWER(`Nick``Name`) = (LOWER(?))",
+		},
+		{
+			name:       "json path within a different column",
+			dialect:    PostgreSQL,
+			input:      "zip == 1",
+			wantClause: `("u"."profile"->'address'->>'zip code')::BIGINT = ($1)`,
+		},
+		{
+			name:       "json path within a different column in sql server",
+			dialect:    SQLServer,
+			input:      "zip == 1",
+			wantClause: `CAST(JSON_VALUE([u].[profile], '$.address."zip code"') AS BIGINT) = (@p1)`,
+		},
+		{
+			name:       "nested field mapped to a plain column",
+			dialect:    PostgreSQL,
+			input:      "company.name == 'A'",
+			wantClause: `LOWER("company_name") = (LOWER($1))`,
+		},
+	}
+
+	parser, err := NewParser(map[string]*exprpb.Type{
+		"name":         {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+		"nickname":     {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+		"zip":          {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_INT64}},
+		"company.name": {TypeKind: &exprpb.Type_Primitive{Primitive: exprpb.Type_STRING}},
+	}, WithColumns(map[string]string{
+		"name":         "u.display_name",
+		"nickname":     `"Nick` + "`" + `Name"`,
+		"zip":          `u.profile->address->"zip code"`,
+		"company.name": "company_name",
+	}))
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			gotExpr, err := parser.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			gotClause, _, err := SQL(gotExpr, WithDialect(tt.dialect))
+			if err != nil {
+				t.Errorf("SQL() error: %v", err)
+			}
+			if gotClause != tt.wantClause {
+				t.Errorf("SQL() got clause: %v, want %v", gotClause, tt.wantClause)
+			}
+		})
 	}
-	return fieldName, nil
 }
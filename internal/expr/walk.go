@@ -0,0 +1,164 @@
+package expr
+
+import "fmt"
+
+// A Visitor's Visit method is invoked for each node encountered by Walk. If
+// the result visitor w is not nil, Walk visits each of the children of node
+// with the visitor w, followed by a call of w.Visit(nil).
+type Visitor interface {
+	Visit(node Node) (w Visitor)
+}
+
+// Walk traverses the node in depth first order: it starts by calling
+// v.Visit(node), and then walks its children, the operand of not, the left
+// and right operands of and and or, the left operand of operations and the
+// field of present and size, with the visitor returned.
+func Walk(v Visitor, node Node) {
+	if v = v.Visit(node); v == nil {
+		return
+	}
+	switch n := node.(type) {
+	case *NotExpr:
+		Walk(v, n.Not)
+	case *AndExpr:
+		Walk(v, n.Left)
+		Walk(v, n.Right)
+	case *OrExpr:
+		Walk(v, n.Left)
+		Walk(v, n.Right)
+	case *OpExpr:
+		Walk(v, n.Left)
+	case *PresentExpr:
+		Walk(v, n.Field)
+	case *SizeExpr:
+		Walk(v, n.Field)
+	}
+	v.Visit(nil)
+}
+
+type inspector func(Node) bool
+
+func (f inspector) Visit(node Node) Visitor {
+	if f(node) {
+		return f
+	}
+	return nil
+}
+
+// Inspect traverses the node in depth first order: it starts by calling
+// f(node), and if f returns true, inspects its children, followed by a call
+// of f(nil).
+func Inspect(node Node, f func(Node) bool) {
+	Walk(inspector(f), node)
+}
+
+// Rewrite returns the expression with every node replaced by the result of
+// f, such as a field renamed, an operation stripped or a predicate injected.
+// Nodes are rewritten bottom up, so f is called with the children already
+// rewritten, and returning nil removes the node: the other operand takes the
+// place of an and or or expression, while not, operation, present and size
+// expressions are removed along with their operand. The expression isn't
+// modified, nodes with children rewritten being copied.
+func Rewrite(expr *Expr, f func(Node) Node) (*Expr, error) {
+	if expr.IsZero() {
+		return &Expr{}, nil
+	}
+	root, err := rewrite(expr.Root, f)
+	if err != nil {
+		return nil, err
+	}
+	return &Expr{Root: root}, nil
+}
+
+func rewrite(node Node, f func(Node) Node) (Node, error) {
+	switch n := node.(type) {
+	case *NotExpr:
+		not, err := rewrite(n.Not, f)
+		if err != nil || not == nil {
+			return nil, err
+		}
+		if not != n.Not {
+			node = &NotExpr{Not: not}
+		}
+	case *AndExpr:
+		left, right, err := rewriteOperands(n.Left, n.Right, f)
+		if err != nil || left == nil || right == nil {
+			return orOperand(left, right), err
+		}
+		if left != n.Left || right != n.Right {
+			node = &AndExpr{Left: left, Right: right}
+		}
+	case *OrExpr:
+		left, right, err := rewriteOperands(n.Left, n.Right, f)
+		if err != nil || left == nil || right == nil {
+			return orOperand(left, right), err
+		}
+		if left != n.Left || right != n.Right {
+			node = &OrExpr{Left: left, Right: right}
+		}
+	case *OpExpr:
+		left, err := rewrite(n.Left, f)
+		if err != nil || left == nil {
+			return nil, err
+		}
+		switch left.(type) {
+		case *Field, *SizeExpr:
+		default:
+			return nil, fmt.Errorf("expr: unsupported left operand of type %T of %s", left, n.Op)
+		}
+		if left != n.Left {
+			node = &OpExpr{Left: left, Op: n.Op, Args: n.Args, CaseSensitive: n.CaseSensitive}
+		}
+	case *PresentExpr:
+		field, err := rewriteField(n.Field, OperatorPresent, f)
+		if err != nil || field == nil {
+			return nil, err
+		}
+		if field != n.Field {
+			node = &PresentExpr{Field: field}
+		}
+	case *SizeExpr:
+		field, err := rewriteField(n.Field, OperatorSize, f)
+		if err != nil || field == nil {
+			return nil, err
+		}
+		if field != n.Field {
+			node = &SizeExpr{Field: field}
+		}
+	}
+	return f(node), nil
+}
+
+func rewriteOperands(left, right Node, f func(Node) Node) (Node, Node, error) {
+	left, err := rewrite(left, f)
+	if err != nil {
+		return nil, nil, err
+	}
+	right, err = rewrite(right, f)
+	if err != nil {
+		return nil, nil, err
+	}
+	return left, right, nil
+}
+
+// orOperand returns whichever operand isn't nil, if any.
+func orOperand(left, right Node) Node {
+	if left == nil {
+		return right
+	}
+	return left
+}
+
+// rewriteField rewrites the field of a present or size expression, which
+// must remain a field.
+func rewriteField(field *Field, op string, f func(Node) Node) (*Field, error) {
+	node, err := rewrite(field, f)
+	if err != nil || node == nil {
+		return nil, err
+	}
+	rewritten, ok := node.(*Field)
+	if !ok {
+		return nil, fmt.Errorf("expr: unsupported operand of type %T of %s", node, op)
+	}
+	return rewritten, nil
+}
@@ -0,0 +1,238 @@
+package expr
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// nodeText describes a node for tests.
+func nodeText(n Node) string {
+	switch n := n.(type) {
+	case nil:
+		return "nil"
+	case *Field:
+		return n.Name
+	case *OpExpr:
+		return n.Op
+	case *NotExpr:
+		return OperatorNot
+	case *AndExpr:
+		return OperatorAnd
+	case *OrExpr:
+		return OperatorOr
+	case *PresentExpr:
+		return OperatorPresent
+	case *SizeExpr:
+		return OperatorSize
+	default:
+		return fmt.Sprintf("%T", n)
+	}
+}
+
+type recorder struct {
+	visited *[]string
+	skip    string
+}
+
+func (r recorder) Visit(node Node) Visitor {
+	*r.visited = append(*r.visited, nodeText(node))
+	if nodeText(node) == r.skip {
+		return nil
+	}
+	return r
+}
+
+func TestWalk(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		skip  string
+		want  []string
+	}{
+		{
+			name:  "every node",
+			input: "age > 1 && (!active || size(tags) == 2 || present(birth_date))",
+			want: []string{
+				"&&", ">", "age", "nil", "nil",
+				"||", "||", "!", "active", "nil", "nil", "==", "size", "tags", "nil", "nil", "nil", "nil",
+				"present", "birth_date", "nil", "nil", "nil", "nil",
+			},
+		},
+		{
+			name:  "skipped children",
+			input: "!active && first_name.startsWith('a')",
+			skip:  "!",
+			want:  []string{"&&", "!", "startsWith", "first_name", "nil", "nil", "nil"},
+		},
+	}
+
+	parser := newSQLTestParser(t)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			e, err := parser.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			var got []string
+			Walk(recorder{visited: &got, skip: tt.skip}, e.Root)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Walk() got: %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInspect(t *testing.T) {
+	t.Parallel()
+
+	parser := newSQLTestParser(t)
+	e, err := parser.Parse("age > 1 && !(first_name == 'a' || active)")
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	var got []string
+	Inspect(e.Root, func(n Node) bool {
+		if n != nil {
+			got = append(got, nodeText(n))
+		}
+		_, isNot := n.(*NotExpr)
+		return !isNot
+	})
+	want := []string{"&&", ">", "age", "!"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Inspect() got: %v, want %v", got, want)
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	t.Parallel()
+
+	parser := newSQLTestParser(t)
+	tests := []struct {
+		name    string
+		input   string
+		f       func(Node) Node
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "unchanged",
+			input: "age > 1 && !present(birth_date)",
+			f:     func(n Node) Node { return n },
+			want:  "age > 1 && !present(birth_date)",
+		},
+		{
+			name:  "rename fields",
+			input: "first_name == 'a' || size(tags) > 1 || present(company.name)",
+			f: func(n Node) Node {
+				if field, ok := n.(*Field); ok {
+					return &Field{Name: "x_" + field.Name, Ftype: field.Ftype}
+				}
+				return n
+			},
+			want: `x_first_name == "a" || size(x_tags) > 1 || present(x_company.name)`,
+		},
+		{
+			name:  "strip clauses",
+			input: "age > 1 && (active || age < 5) && !(age == 3)",
+			f: func(n Node) Node {
+				if op, ok := n.(*OpExpr); ok && op.Left.(*Field).Name == "age" {
+					return nil
+				}
+				return n
+			},
+			want: "active",
+		},
+		{
+			name:  "strip everything",
+			input: "age > 1 || age < 0",
+			f: func(n Node) Node {
+				if _, ok := n.(*Field); ok {
+					return nil
+				}
+				return n
+			},
+			want: "",
+		},
+		{
+			name:  "inject predicate",
+			input: "first_name == 'a' || active",
+			f: func(n Node) Node {
+				if field, ok := n.(*Field); ok && field.Name == "active" {
+					return &AndExpr{Left: n, Right: &PresentExpr{Field: parser.fields["birth_date"]}}
+				}
+				return n
+			},
+			want: `first_name == "a" || active && present(birth_date)`,
+		},
+		{
+			name:  "unsupported operand",
+			input: "age > 1",
+			f: func(n Node) Node {
+				if _, ok := n.(*Field); ok {
+					return &NotExpr{Not: parser.fields["active"]}
+				}
+				return n
+			},
+			wantErr: true,
+		},
+		{
+			name:  "unsupported field",
+			input: "present(birth_date)",
+			f: func(n Node) Node {
+				if _, ok := n.(*Field); ok {
+					return &SizeExpr{Field: parser.fields["tags"]}
+				}
+				return n
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			e, err := parser.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			before := formatted(e)
+			got, err := Rewrite(e, tt.f)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Rewrite() error: %v, wantErr %v", err, tt.wantErr)
+			}
+			if after := formatted(e); after != before {
+				t.Errorf("Rewrite() modified the expression: %s, want %s", after, before)
+			}
+			if err != nil {
+				return
+			}
+			if formatted(got) != tt.want {
+				t.Errorf("Rewrite() got: %s, want %s", formatted(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestRewriteUnchanged(t *testing.T) {
+	t.Parallel()
+
+	parser := newSQLTestParser(t)
+	e, err := parser.Parse("age > 1 && !present(birth_date)")
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	got, err := Rewrite(e, func(n Node) Node { return n })
+	if err != nil {
+		t.Fatalf("Rewrite() error: %v", err)
+	}
+	if got.Root != e.Root {
+		t.Errorf("Rewrite() copied unchanged nodes")
+	}
+}
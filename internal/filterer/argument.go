@@ -0,0 +1,54 @@
+package filterer
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	filtererpb "github.com/lopezator/filterer/proto/lopezator/filterer/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// arguments converts SQL args to their typed protobuf representation.
+func arguments(args []any) ([]*filtererpb.Argument, error) {
+	pbArgs := make([]*filtererpb.Argument, len(args))
+	for i, arg := range args {
+		var err error
+		pbArgs[i], err = argument(arg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return pbArgs, nil
+}
+
+// argument converts a SQL arg to its typed protobuf representation.
+func argument(arg any) (*filtererpb.Argument, error) {
+	switch v := arg.(type) {
+	case nil:
+		return &filtererpb.Argument{Value: &filtererpb.Argument_NullValue{}}, nil
+	case string:
+		return &filtererpb.Argument{Value: &filtererpb.Argument_StringValue{StringValue: v}}, nil
+	case int64:
+		return &filtererpb.Argument{Value: &filtererpb.Argument_Int64Value{Int64Value: v}}, nil
+	case uint64:
+		if v > math.MaxInt64 {
+			return nil, fmt.Errorf("filterer: argument %d overflows int64", v)
+		}
+		return &filtererpb.Argument{Value: &filtererpb.Argument_Int64Value{Int64Value: int64(v)}}, nil
+	case float64:
+		return &filtererpb.Argument{Value: &filtererpb.Argument_DoubleValue{DoubleValue: v}}, nil
+	case bool:
+		return &filtererpb.Argument{Value: &filtererpb.Argument_BoolValue{BoolValue: v}}, nil
+	case []byte:
+		return &filtererpb.Argument{Value: &filtererpb.Argument_BytesValue{BytesValue: v}}, nil
+	case time.Time:
+		return &filtererpb.Argument{Value: &filtererpb.Argument_TimestampValue{TimestampValue: timestamppb.New(v)}}, nil
+	case []string:
+		return &filtererpb.Argument{Value: &filtererpb.Argument_StringListValue{
+			StringListValue: &filtererpb.StringList{Values: v},
+		}}, nil
+	default:
+		return nil, fmt.Errorf("filterer: unsupported argument of type %T", arg)
+	}
+}
@@ -0,0 +1,44 @@
+package filterer
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/lopezator/filterer/internal/expr"
+	filtererpb "github.com/lopezator/filterer/proto/lopezator/filterer/v1"
+)
+
+// suggestionKindLookup maps the expr suggestion kinds to their protobuf
+// representation.
+var suggestionKindLookup = map[expr.SuggestionKind]filtererpb.SuggestionKind{
+	expr.FieldSuggestionKind:    filtererpb.SuggestionKind_SUGGESTION_KIND_FIELD,
+	expr.OperatorSuggestionKind: filtererpb.SuggestionKind_SUGGESTION_KIND_OPERATOR,
+	expr.MethodSuggestionKind:   filtererpb.SuggestionKind_SUGGESTION_KIND_METHOD,
+	expr.FunctionSuggestionKind: filtererpb.SuggestionKind_SUGGESTION_KIND_FUNCTION,
+	expr.LiteralSuggestionKind:  filtererpb.SuggestionKind_SUGGESTION_KIND_LITERAL,
+}
+
+// Complete implements filterer.FiltererServiceServer.Complete.
+func (s *Service) Complete(ctx context.Context, req *connect.Request[filtererpb.CompleteRequest]) (*connect.Response[filtererpb.CompleteResponse], error) {
+	// Select the parser of the requested field set.
+	parser, err := s.parser(req.Msg.FieldSetId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Complete the expression at the cursor.
+	suggestions := parser.Complete(req.Msg.Expr, int(req.Msg.Cursor))
+	pbSuggestions := make([]*filtererpb.Suggestion, len(suggestions))
+	for i, suggestion := range suggestions {
+		pbSuggestions[i] = &filtererpb.Suggestion{
+			Text:         suggestion.Text,
+			Kind:         suggestionKindLookup[suggestion.Kind],
+			Detail:       suggestion.Detail,
+			ReplaceStart: int32(suggestion.Start),
+			ReplaceEnd:   int32(suggestion.End),
+		}
+	}
+	return connect.NewResponse(&filtererpb.CompleteResponse{
+		Suggestions: pbSuggestions,
+	}), nil
+}
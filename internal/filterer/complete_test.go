@@ -0,0 +1,87 @@
+package filterer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	filtererpb "github.com/lopezator/filterer/proto/lopezator/filterer/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestComplete(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, []*FieldSet{
+		{ID: "users", Fields: []*Field{{Name: "name", Type: "string"}, {Name: "nickname", Type: "string"}}},
+		{ID: "orders", Fields: []*Field{{Name: "name", Type: "integer"}}},
+	})
+
+	tests := []struct {
+		name       string
+		fieldSetID string
+		input      string
+		cursor     int32
+		want       *filtererpb.CompleteResponse
+		wantCode   connect.Code
+	}{
+		{
+			name:       "fields of the requested field set",
+			fieldSetID: "users",
+			input:      "name == 'a' || n",
+			cursor:     16,
+			want: &filtererpb.CompleteResponse{
+				Suggestions: []*filtererpb.Suggestion{
+					{Text: "name", Kind: filtererpb.SuggestionKind_SUGGESTION_KIND_FIELD, Detail: "string", ReplaceStart: 15, ReplaceEnd: 16},
+					{Text: "nickname", Kind: filtererpb.SuggestionKind_SUGGESTION_KIND_FIELD, Detail: "string", ReplaceStart: 15, ReplaceEnd: 16},
+					{Text: "present(", Kind: filtererpb.SuggestionKind_SUGGESTION_KIND_FUNCTION, ReplaceStart: 15, ReplaceEnd: 16},
+				},
+			},
+		},
+		{
+			name:       "operators of the field type",
+			fieldSetID: "orders",
+			input:      "name > 1 && name ",
+			cursor:     17,
+			want: &filtererpb.CompleteResponse{
+				Suggestions: []*filtererpb.Suggestion{
+					{Text: "==", Kind: filtererpb.SuggestionKind_SUGGESTION_KIND_OPERATOR, ReplaceStart: 17, ReplaceEnd: 17},
+					{Text: "!=", Kind: filtererpb.SuggestionKind_SUGGESTION_KIND_OPERATOR, ReplaceStart: 17, ReplaceEnd: 17},
+					{Text: "in", Kind: filtererpb.SuggestionKind_SUGGESTION_KIND_OPERATOR, ReplaceStart: 17, ReplaceEnd: 17},
+					{Text: "<", Kind: filtererpb.SuggestionKind_SUGGESTION_KIND_OPERATOR, ReplaceStart: 17, ReplaceEnd: 17},
+					{Text: "<=", Kind: filtererpb.SuggestionKind_SUGGESTION_KIND_OPERATOR, ReplaceStart: 17, ReplaceEnd: 17},
+					{Text: ">", Kind: filtererpb.SuggestionKind_SUGGESTION_KIND_OPERATOR, ReplaceStart: 17, ReplaceEnd: 17},
+					{Text: ">=", Kind: filtererpb.SuggestionKind_SUGGESTION_KIND_OPERATOR, ReplaceStart: 17, ReplaceEnd: 17},
+				},
+			},
+		},
+		{
+			name:       "unknown field set",
+			fieldSetID: "invoices",
+			input:      "n",
+			wantCode:   connect.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := client.Complete(context.Background(), connect.NewRequest(&filtererpb.CompleteRequest{
+				FieldSetId: tt.fieldSetID,
+				Expr:       tt.input,
+				Cursor:     tt.cursor,
+			}))
+			var connectErr *connect.Error
+			switch {
+			case tt.wantCode == 0 && err != nil:
+				t.Errorf("Complete() error: %v", err)
+			case tt.wantCode != 0 && (!errors.As(err, &connectErr) || connectErr.Code() != tt.wantCode):
+				t.Errorf("Complete() error: %v, want code %v", err, tt.wantCode)
+			case tt.want != nil && !proto.Equal(got.Msg, tt.want):
+				t.Errorf("Complete() got: %v, want %v", got.Msg, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package filterer
+
+import (
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/lopezator/filterer/internal/expr"
+)
+
+// Default is a predicate, in CEL, ANDed into every expression of the field set,
+// such as deleted_at == null. Defaults marked UnlessReferenced are dropped
+// from the expressions referencing any of their fields, so they can be
+// overridden by filtering on them explicitly.
+type Default struct {
+	Expr             string
+	UnlessReferenced bool `yaml:"unless_referenced"`
+}
+
+// parsedDefault is a default of a field set, parsed at startup to check it
+// and find its fields. Its expression is parsed again for every request, as
+// now() is resolved at parse time.
+type parsedDefault struct {
+	expr             string
+	filter           *expr.Expr
+	unlessReferenced bool
+}
+
+// parseDefaults parses the defaults of a field set with its parser.
+func parseDefaults(parser *expr.Parser, defaults []*Default) ([]*parsedDefault, error) {
+	parsed := make([]*parsedDefault, 0, len(defaults))
+	for _, def := range defaults {
+		filter, err := parser.Parse(def.Expr)
+		if err != nil {
+			return nil, fmt.Errorf("filterer: invalid default %q: %w", def.Expr, err)
+		}
+		if filter.IsZero() {
+			return nil, errors.New("filterer: empty default")
+		}
+		parsed = append(parsed, &parsedDefault{expr: def.Expr, filter: filter, unlessReferenced: def.UnlessReferenced})
+	}
+	return parsed, nil
+}
+
+// defaulted returns the expression ANDed with the defaults of the field set
+// that apply to it, parsed anew so that now() is the time of the request.
+func (s *Service) defaulted(fieldSetID string, filter *expr.Expr) (*expr.Expr, error) {
+	defaults := s.defaults[fieldSetID]
+	if len(defaults) == 0 {
+		return filter, nil
+	}
+	parser := s.parsers[fieldSetID]
+	referenced := make(map[string]bool)
+	for _, name := range filter.Fields() {
+		referenced[name] = true
+	}
+	root := filter.Root
+	for _, def := range defaults {
+		if def.unlessReferenced && referencesAny(def.filter, referenced) {
+			continue
+		}
+		defFilter, err := parser.Parse(def.expr)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("filterer: invalid default %q: %w", def.expr, err))
+		}
+		if root == nil {
+			root = defFilter.Root
+			continue
+		}
+		root = &expr.AndExpr{Left: root, Right: defFilter.Root}
+	}
+	return &expr.Expr{Root: root}, nil
+}
+
+// referencesAny reports whether the expression references any of the fields.
+func referencesAny(filter *expr.Expr, fields map[string]bool) bool {
+	for _, name := range filter.Fields() {
+		if fields[name] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package filterer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	filtererpb "github.com/lopezator/filterer/proto/lopezator/filterer/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFilterDefaults(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, []*FieldSet{{
+		ID: "users",
+		Fields: []*Field{
+			{Name: "name", Type: "string"},
+			{Name: "status", Type: "string"},
+			{Name: "deleted_at", Type: "timestamp"},
+		},
+		Defaults: []*Default{
+			{Expr: "deleted_at == null"},
+			{Expr: "status != 'archived'", UnlessReferenced: true},
+		},
+	}})
+
+	tests := []struct {
+		name  string
+		input string
+		want  *filtererpb.FilterResponse
+	}{
+		{
+			name:  "defaults",
+			input: "name == 'a' || name == 'b'",
+			want: &filtererpb.FilterResponse{
+				Where: `(((LOWER("name") = (LOWER($1)) OR LOWER("name") = (LOWER($2))) AND "deleted_at" IS NULL) AND LOWER("status") <> (LOWER($3)))`,
+				Args:  []*filtererpb.Argument{stringArgument("a"), stringArgument("b"), stringArgument("archived")},
+			},
+		},
+		{
+			name:  "referenced default",
+			input: "status == 'archived'",
+			want: &filtererpb.FilterResponse{
+				Where: `(LOWER("status") = (LOWER($1)) AND "deleted_at" IS NULL)`,
+				Args:  []*filtererpb.Argument{stringArgument("archived")},
+			},
+		},
+		{
+			name:  "default kept when referenced",
+			input: "deleted_at != null",
+			want: &filtererpb.FilterResponse{
+				Where: `(("deleted_at" IS NOT NULL AND "deleted_at" IS NULL) AND LOWER("status") <> (LOWER($1)))`,
+				Args:  []*filtererpb.Argument{stringArgument("archived")},
+			},
+		},
+		{
+			name: "empty expression",
+			want: &filtererpb.FilterResponse{
+				Where: `("deleted_at" IS NULL AND LOWER("status") <> (LOWER($1)))`,
+				Args:  []*filtererpb.Argument{stringArgument("archived")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := client.Filter(context.Background(), connect.NewRequest(&filtererpb.FilterRequest{
+				FieldSetId: "users",
+				Expr:       tt.input,
+			}))
+			if err != nil {
+				t.Fatalf("Filter() error: %v", err)
+			}
+			if !proto.Equal(got.Msg, tt.want) {
+				t.Errorf("Filter() got: %v, want %v", got.Msg, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterDefaultsNow(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, []*FieldSet{{
+		ID:       "users",
+		Fields:   []*Field{{Name: "created_at", Type: "timestamp"}},
+		Defaults: []*Default{{Expr: "created_at > now() - duration('1h')"}},
+	}})
+
+	// now() is resolved per request, not when the default was parsed
+	before := time.Now()
+	got, err := client.Filter(context.Background(), connect.NewRequest(&filtererpb.FilterRequest{FieldSetId: "users"}))
+	if err != nil {
+		t.Fatalf("Filter() error: %v", err)
+	}
+	if len(got.Msg.Args) != 1 {
+		t.Fatalf("Filter() got args: %v, want 1", got.Msg.Args)
+	}
+	if arg := got.Msg.Args[0].GetTimestampValue().AsTime(); arg.Before(before.Add(-time.Hour)) {
+		t.Errorf("Filter() got arg: %v, want after %v", arg, before.Add(-time.Hour))
+	}
+}
+
+func TestNewServiceDefaults(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		defaults []*Default
+	}{
+		{name: "empty", defaults: []*Default{{}}},
+		{name: "unknown field", defaults: []*Default{{Expr: "org == 'a'"}}},
+		{name: "syntax error", defaults: []*Default{{Expr: "status =="}}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewService() didn't panic")
+				}
+			}()
+			NewService([]*FieldSet{{
+				ID:       "users",
+				Fields:   []*Field{{Name: "status", Type: "string"}},
+				Defaults: tt.defaults,
+			}})
+		})
+	}
+}
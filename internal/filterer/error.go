@@ -0,0 +1,65 @@
+package filterer
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/lopezator/filterer/internal/expr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// errorDomain is the domain of the ErrorInfo details.
+const errorDomain = "filterer.lopezator.github.com"
+
+// exprError converts an error parsing the expression to an InvalidArgument
+// connect error, detailing its position so clients can point it out.
+func exprError(err error) error {
+	var exprErr *expr.Error
+	if !errors.As(err, &exprErr) {
+		return fmt.Errorf("filterer: %w", err)
+	}
+	connectErr := connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("filterer: %w", err))
+
+	// Add the violation of the expr field of the request.
+	badRequest, detailErr := connect.NewErrorDetail(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       "expr",
+			Description: exprErr.Msg,
+		}},
+	})
+	if detailErr != nil {
+		return fmt.Errorf("filterer: %w", detailErr)
+	}
+	connectErr.AddDetail(badRequest)
+
+	// Add the machine readable description of the error.
+	metadata := map[string]string{
+		"offset": strconv.Itoa(exprErr.Offset),
+		"end":    strconv.Itoa(exprErr.End),
+		"line":   strconv.Itoa(exprErr.Line),
+		"column": strconv.Itoa(exprErr.Column),
+	}
+	if exprErr.Field != "" {
+		metadata["field"] = exprErr.Field
+	}
+	if exprErr.Operator != "" {
+		metadata["operator"] = exprErr.Operator
+	}
+	if len(exprErr.Suggestions) > 0 {
+		metadata["suggestions"] = strings.Join(exprErr.Suggestions, ",")
+	}
+	errorInfo, detailErr := connect.NewErrorDetail(&errdetails.ErrorInfo{
+		Reason:   strings.ToUpper(exprErr.Kind.String()),
+		Domain:   errorDomain,
+		Metadata: metadata,
+	})
+	if detailErr != nil {
+		return fmt.Errorf("filterer: %w", detailErr)
+	}
+	connectErr.AddDetail(errorInfo)
+
+	return connectErr
+}
@@ -0,0 +1,53 @@
+package filterer
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/lopezator/filterer/internal/expr"
+	filtererpb "github.com/lopezator/filterer/proto/lopezator/filterer/v1"
+)
+
+// Evaluate implements filterer.FiltererServiceServer.Evaluate.
+func (s *Service) Evaluate(ctx context.Context, req *connect.Request[filtererpb.EvaluateRequest]) (*connect.Response[filtererpb.EvaluateResponse], error) {
+	// Select the parser of the requested field set.
+	parser, err := s.parser(req.Msg.FieldSetId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse the expression.
+	filter, err := parser.Parse(req.Msg.Expr)
+	if err != nil {
+		return nil, exprError(err)
+	}
+
+	// Apply the defaults of the field set, and restrict the expression to the
+	// scope of the caller.
+	filter, err = s.defaulted(req.Msg.FieldSetId, filter)
+	if err != nil {
+		return nil, err
+	}
+	filter, err = s.scoped(ctx, req.Header(), req.Msg.FieldSetId, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Match the records, failing on values not fitting their fields.
+	res := &filtererpb.EvaluateResponse{}
+	for i, record := range req.Msg.Records {
+		ok, err := expr.Match(filter, record)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("filterer: record %d: %w", i, err))
+		}
+		if !ok {
+			continue
+		}
+		res.Indices = append(res.Indices, int32(i))
+		if req.Msg.IncludeRecords {
+			res.Records = append(res.Records, record)
+		}
+	}
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,127 @@
+package filterer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	filtererpb "github.com/lopezator/filterer/proto/lopezator/filterer/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, []*FieldSet{
+		{ID: "users", Fields: []*Field{
+			{Name: "name", Type: "string", Column: "u.display_name"},
+			{Name: "age", Type: "integer"},
+			{Name: "address.city", Type: "string"},
+			{Name: "created_at", Type: "timestamp"},
+		}},
+	})
+
+	newRecord := func(fields map[string]any) *structpb.Struct {
+		record, err := structpb.NewStruct(fields)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		return record
+	}
+	records := []*structpb.Struct{
+		newRecord(map[string]any{"name": "Paco", "age": 35, "address": map[string]any{"city": "Madrid"}}),
+		newRecord(map[string]any{"name": "Ana", "age": 17, "created_at": "2024-01-01T00:00:00Z"}),
+		newRecord(map[string]any{"name": "paco"}),
+	}
+
+	tests := []struct {
+		name           string
+		fieldSetID     string
+		input          string
+		records        []*structpb.Struct
+		includeRecords bool
+		want           *filtererpb.EvaluateResponse
+		wantCode       connect.Code
+	}{
+		{
+			name:       "matching indices",
+			fieldSetID: "users",
+			input:      "name == 'PACO'",
+			records:    records,
+			want:       &filtererpb.EvaluateResponse{Indices: []int32{0, 2}},
+		},
+		{
+			name:           "matching records",
+			fieldSetID:     "users",
+			input:          "address.city.startsWith('mad') || created_at < timestamp('2025-01-01T00:00:00Z')",
+			records:        records,
+			includeRecords: true,
+			want: &filtererpb.EvaluateResponse{
+				Indices: []int32{0, 1},
+				Records: records[:2],
+			},
+		},
+		{
+			name:       "null semantics",
+			fieldSetID: "users",
+			input:      "!(age >= 18)",
+			records:    records,
+			want:       &filtererpb.EvaluateResponse{Indices: []int32{1}},
+		},
+		{
+			name:       "empty expression",
+			fieldSetID: "users",
+			records:    records,
+			want:       &filtererpb.EvaluateResponse{Indices: []int32{0, 1, 2}},
+		},
+		{
+			name:       "no records",
+			fieldSetID: "users",
+			input:      "age > 1",
+			want:       &filtererpb.EvaluateResponse{},
+		},
+		{
+			name:       "invalid record",
+			fieldSetID: "users",
+			input:      "age > 1",
+			records:    []*structpb.Struct{newRecord(map[string]any{"age": "old"})},
+			wantCode:   connect.CodeInvalidArgument,
+		},
+		{
+			name:       "invalid expression",
+			fieldSetID: "users",
+			input:      "age == 'a'",
+			wantCode:   connect.CodeInvalidArgument,
+		},
+		{
+			name:       "unknown field set",
+			fieldSetID: "invoices",
+			input:      "age > 1",
+			wantCode:   connect.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := client.Evaluate(context.Background(), connect.NewRequest(&filtererpb.EvaluateRequest{
+				FieldSetId:     tt.fieldSetID,
+				Expr:           tt.input,
+				Records:        tt.records,
+				IncludeRecords: tt.includeRecords,
+			}))
+			var connectErr *connect.Error
+			switch {
+			case tt.wantCode == 0 && err != nil:
+				t.Errorf("Evaluate() error: %v", err)
+			case tt.wantCode != 0 && (!errors.As(err, &connectErr) || connectErr.Code() != tt.wantCode):
+				t.Errorf("Evaluate() error: %v, want code %v", err, tt.wantCode)
+			case tt.want != nil && !proto.Equal(got.Msg, tt.want):
+				t.Errorf("Evaluate() got: %v, want %v", got.Msg, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package filterer
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/lopezator/filterer/internal/expr"
+	filtererpb "github.com/lopezator/filterer/proto/lopezator/filterer/v1"
+)
+
+// nodeKindLookup maps the expr node kinds to their protobuf representation.
+var nodeKindLookup = map[string]filtererpb.NodeKind{
+	expr.KindNot:     filtererpb.NodeKind_NODE_KIND_NOT,
+	expr.KindAnd:     filtererpb.NodeKind_NODE_KIND_AND,
+	expr.KindOr:      filtererpb.NodeKind_NODE_KIND_OR,
+	expr.KindOp:      filtererpb.NodeKind_NODE_KIND_OP,
+	expr.KindPresent: filtererpb.NodeKind_NODE_KIND_PRESENT,
+	expr.KindSize:    filtererpb.NodeKind_NODE_KIND_SIZE,
+	expr.KindField:   filtererpb.NodeKind_NODE_KIND_FIELD,
+}
+
+// Explain implements filterer.FiltererServiceServer.Explain.
+func (s *Service) Explain(ctx context.Context, req *connect.Request[filtererpb.ExplainRequest]) (*connect.Response[filtererpb.ExplainResponse], error) {
+	// Select the parser of the requested field set.
+	parser, err := s.parser(req.Msg.FieldSetId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse the expression.
+	filter, err := parser.Parse(req.Msg.Expr)
+	if err != nil {
+		return nil, exprError(err)
+	}
+
+	// Apply the defaults of the field set, and restrict the expression to the
+	// scope of the caller.
+	filter, err = s.defaulted(req.Msg.FieldSetId, filter)
+	if err != nil {
+		return nil, err
+	}
+	filter, err = s.scoped(ctx, req.Header(), req.Msg.FieldSetId, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Select the dialect.
+	dialect, ok := dialectLookup[req.Msg.Dialect]
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("filterer: unknown dialect %v", req.Msg.Dialect))
+	}
+
+	// Explain the expression.
+	explanation, err := expr.Explain(filter, expr.WithDialect(dialect))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("filterer: %w", err))
+	}
+	pbArgs, err := arguments(explanation.Args)
+	if err != nil {
+		return nil, fmt.Errorf("filterer: %w", err)
+	}
+	res := &filtererpb.ExplainResponse{
+		Where: explanation.SQL,
+		Args:  pbArgs,
+	}
+	if explanation.Root != nil {
+		res.Root, err = explainNode(explanation.Root)
+		if err != nil {
+			return nil, fmt.Errorf("filterer: %w", err)
+		}
+	}
+	return connect.NewResponse(res), nil
+}
+
+// explainNode converts an explained node to its protobuf representation.
+func explainNode(node *expr.ExplainNode) (*filtererpb.ExplainNode, error) {
+	args, err := arguments(node.Args)
+	if err != nil {
+		return nil, err
+	}
+	pbNode := &filtererpb.ExplainNode{
+		Kind:     nodeKindLookup[node.Kind],
+		Operator: node.Operator,
+		Field:    node.Field,
+		Args:     args,
+		Sql:      node.SQL,
+	}
+	for ftype, pbType := range fieldTypeLookup {
+		if node.FieldType != "" && ftype.String() == node.FieldType {
+			pbNode.FieldType = pbType
+		}
+	}
+	for _, child := range node.Children {
+		pbChild, err := explainNode(child)
+		if err != nil {
+			return nil, err
+		}
+		pbNode.Children = append(pbNode.Children, pbChild)
+	}
+	return pbNode, nil
+}
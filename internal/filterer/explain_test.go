@@ -0,0 +1,108 @@
+package filterer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	filtererpb "github.com/lopezator/filterer/proto/lopezator/filterer/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, []*FieldSet{
+		{ID: "users", Fields: []*Field{{Name: "name", Type: "string", Column: "u.display_name"}, {Name: "age", Type: "integer"}}},
+	})
+
+	tests := []struct {
+		name       string
+		fieldSetID string
+		input      string
+		dialect    filtererpb.Dialect
+		want       *filtererpb.ExplainResponse
+		wantCode   connect.Code
+	}{
+		{
+			name:       "explain expression",
+			fieldSetID: "users",
+			input:      "name != null && age >= 18",
+			dialect:    filtererpb.Dialect_DIALECT_SQLSERVER,
+			want: &filtererpb.ExplainResponse{
+				Root: &filtererpb.ExplainNode{
+					Kind:     filtererpb.NodeKind_NODE_KIND_AND,
+					Operator: "&&",
+					Sql:      "([u].[display_name] IS NOT NULL AND [age] >= (@p1))",
+					Children: []*filtererpb.ExplainNode{
+						{
+							Kind:     filtererpb.NodeKind_NODE_KIND_OP,
+							Operator: "!=",
+							Args:     []*filtererpb.Argument{{Value: &filtererpb.Argument_NullValue{}}},
+							Sql:      "[u].[display_name] IS NOT NULL",
+							Children: []*filtererpb.ExplainNode{{
+								Kind:      filtererpb.NodeKind_NODE_KIND_FIELD,
+								Field:     "name",
+								FieldType: filtererpb.FieldType_FIELD_TYPE_STRING,
+								Sql:       "[u].[display_name]",
+							}},
+						},
+						{
+							Kind:     filtererpb.NodeKind_NODE_KIND_OP,
+							Operator: ">=",
+							Args:     []*filtererpb.Argument{{Value: &filtererpb.Argument_Int64Value{Int64Value: 18}}},
+							Sql:      "[age] >= (@p1)",
+							Children: []*filtererpb.ExplainNode{{
+								Kind:      filtererpb.NodeKind_NODE_KIND_FIELD,
+								Field:     "age",
+								FieldType: filtererpb.FieldType_FIELD_TYPE_INTEGER,
+								Sql:       "[age]",
+							}},
+						},
+					},
+				},
+				Where: "([u].[display_name] IS NOT NULL AND [age] >= (@p1))",
+				Args:  []*filtererpb.Argument{{Value: &filtererpb.Argument_Int64Value{Int64Value: 18}}},
+			},
+		},
+		{
+			name:       "empty expression",
+			fieldSetID: "users",
+			want:       &filtererpb.ExplainResponse{},
+		},
+		{
+			name:       "invalid expression",
+			fieldSetID: "users",
+			input:      "age == 'a'",
+			wantCode:   connect.CodeInvalidArgument,
+		},
+		{
+			name:       "unknown field set",
+			fieldSetID: "invoices",
+			input:      "age == 1",
+			wantCode:   connect.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := client.Explain(context.Background(), connect.NewRequest(&filtererpb.ExplainRequest{
+				FieldSetId: tt.fieldSetID,
+				Expr:       tt.input,
+				Dialect:    tt.dialect,
+			}))
+			var connectErr *connect.Error
+			switch {
+			case tt.wantCode == 0 && err != nil:
+				t.Errorf("Explain() error: %v", err)
+			case tt.wantCode != 0 && (!errors.As(err, &connectErr) || connectErr.Code() != tt.wantCode):
+				t.Errorf("Explain() error: %v, want code %v", err, tt.wantCode)
+			case tt.want != nil && !proto.Equal(got.Msg, tt.want):
+				t.Errorf("Explain() got: %v, want %v", got.Msg, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package filterer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lopezator/filterer/internal/expr"
+	filtererpb "github.com/lopezator/filterer/proto/lopezator/filterer/v1"
+)
+
+// expression converts a parsed expression to its protobuf representation,
+// which case sensitive comparisons have none.
+func expression(e *expr.Expr) (*filtererpb.Expression, error) {
+	if e.IsZero() {
+		return &filtererpb.Expression{}, nil
+	}
+	root, err := node(e.Root)
+	if err != nil {
+		return nil, err
+	}
+	return &filtererpb.Expression{Root: root}, nil
+}
+
+// node converts an expression node to its protobuf representation.
+func node(n expr.Node) (*filtererpb.Node, error) {
+	switch e := n.(type) {
+	case *expr.NotExpr:
+		not, err := node(e.Not)
+		if err != nil {
+			return nil, err
+		}
+		return &filtererpb.Node{Node: &filtererpb.Node_Not{Not: not}}, nil
+	case *expr.AndExpr:
+		and, err := binaryNode(e.Left, e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &filtererpb.Node{Node: &filtererpb.Node_And{And: and}}, nil
+	case *expr.OrExpr:
+		or, err := binaryNode(e.Left, e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &filtererpb.Node{Node: &filtererpb.Node_Or{Or: or}}, nil
+	case *expr.OpExpr:
+		// there's no representation of case sensitive comparisons, which would
+		// be converted back as case insensitive
+		if e.CaseSensitive {
+			return nil, fmt.Errorf("filterer: unsupported case sensitive %s", e.Op)
+		}
+		left, err := node(e.Left)
+		if err != nil {
+			return nil, err
+		}
+		args := make([]*filtererpb.Argument, len(e.Args))
+		for i, arg := range e.Args {
+			// unsigned integers are kept as such, unlike in SQL arguments
+			if v, ok := arg.(uint64); ok {
+				args[i] = &filtererpb.Argument{Value: &filtererpb.Argument_Uint64Value{Uint64Value: v}}
+				continue
+			}
+			args[i], err = argument(arg)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &filtererpb.Node{Node: &filtererpb.Node_Op{Op: &filtererpb.OperationNode{
+			Left: left,
+			Op:   e.Op,
+			Args: args,
+		}}}, nil
+	case *expr.PresentExpr:
+		return &filtererpb.Node{Node: &filtererpb.Node_Present{Present: fieldNode(e.Field)}}, nil
+	case *expr.SizeExpr:
+		return &filtererpb.Node{Node: &filtererpb.Node_Size{Size: fieldNode(e.Field)}}, nil
+	case *expr.Field:
+		return &filtererpb.Node{Node: &filtererpb.Node_Field{Field: fieldNode(e)}}, nil
+	default:
+		return nil, fmt.Errorf("filterer: unsupported node of type %T", n)
+	}
+}
+
+func binaryNode(left, right expr.Node) (*filtererpb.BinaryNode, error) {
+	l, err := node(left)
+	if err != nil {
+		return nil, err
+	}
+	r, err := node(right)
+	if err != nil {
+		return nil, err
+	}
+	return &filtererpb.BinaryNode{Left: l, Right: r}, nil
+}
+
+func fieldNode(field *expr.Field) *filtererpb.FieldNode {
+	return &filtererpb.FieldNode{
+		Name: field.Name,
+		Type: fieldTypeLookup[field.Ftype],
+	}
+}
+
+// parsedExpression converts a protobuf expression back to a parsed one. It's
+// rebuilt against the parser, so its fields must be known by it with the same
+// type, and its operators and args must fit them, as if parsed.
+func parsedExpression(parser *expr.Parser, pbExpr *filtererpb.Expression) (*expr.Expr, error) {
+	if pbExpr.Root == nil {
+		return &expr.Expr{}, nil
+	}
+	cond, err := parsedCond(parser, pbExpr.Root)
+	if err != nil {
+		return nil, err
+	}
+	return parser.Build(cond)
+}
+
+// parsedCond converts a protobuf node to the condition it's rebuilt from.
+func parsedCond(parser *expr.Parser, pbNode *filtererpb.Node) (expr.Cond, error) {
+	switch n := pbNode.GetNode().(type) {
+	case *filtererpb.Node_Not:
+		not, err := parsedCond(parser, n.Not)
+		if err != nil {
+			return nil, err
+		}
+		return expr.Not(not), nil
+	case *filtererpb.Node_And:
+		left, right, err := parsedBinaryCond(parser, n.And)
+		if err != nil {
+			return nil, err
+		}
+		return expr.And(left, right), nil
+	case *filtererpb.Node_Or:
+		left, right, err := parsedBinaryCond(parser, n.Or)
+		if err != nil {
+			return nil, err
+		}
+		return expr.Or(left, right), nil
+	case *filtererpb.Node_Op:
+		var args []any
+		for _, pbArg := range n.Op.Args {
+			arg, err := argumentValue(pbArg)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+		}
+		switch left := n.Op.GetLeft().GetNode().(type) {
+		case *filtererpb.Node_Field:
+			field, err := parsedField(parser, left.Field)
+			if err != nil {
+				return nil, err
+			}
+			return expr.Compare(field.Name, n.Op.Op, args...), nil
+		case *filtererpb.Node_Size:
+			field, err := parsedField(parser, left.Size)
+			if err != nil {
+				return nil, err
+			}
+			return expr.Size(field.Name).Compare(n.Op.Op, args...), nil
+		case nil:
+			return nil, errors.New("filterer: missing operand of operation node")
+		default:
+			return nil, errors.New("filterer: operand of operation node must be a field or its size")
+		}
+	case *filtererpb.Node_Present:
+		field, err := parsedField(parser, n.Present)
+		if err != nil {
+			return nil, err
+		}
+		return expr.Present(field.Name), nil
+	case *filtererpb.Node_Size:
+		return nil, errors.New("filterer: size node must be the operand of an operation node")
+	case *filtererpb.Node_Field:
+		field, err := parsedField(parser, n.Field)
+		if err != nil {
+			return nil, err
+		}
+		return expr.Bool(field.Name), nil
+	default:
+		return nil, errors.New("filterer: missing node")
+	}
+}
+
+func parsedBinaryCond(parser *expr.Parser, pbNode *filtererpb.BinaryNode) (expr.Cond, expr.Cond, error) {
+	if pbNode.Left == nil || pbNode.Right == nil {
+		return nil, nil, errors.New("filterer: missing operand of binary node")
+	}
+	left, err := parsedCond(parser, pbNode.Left)
+	if err != nil {
+		return nil, nil, err
+	}
+	right, err := parsedCond(parser, pbNode.Right)
+	if err != nil {
+		return nil, nil, err
+	}
+	return left, right, nil
+}
+
+func parsedField(parser *expr.Parser, pbField *filtererpb.FieldNode) (*expr.Field, error) {
+	if pbField == nil {
+		return nil, errors.New("filterer: missing field")
+	}
+	field, ok := parser.Field(pbField.Name)
+	if !ok {
+		return nil, fmt.Errorf("filterer: unknown field %q", pbField.Name)
+	}
+	if fieldTypeLookup[field.Ftype] != pbField.Type {
+		return nil, fmt.Errorf("filterer: field %q is of type %v, not %v", field.Name, fieldTypeLookup[field.Ftype], pbField.Type)
+	}
+	return field, nil
+}
+
+// argumentValue converts a typed protobuf argument back to its value.
+func argumentValue(arg *filtererpb.Argument) (any, error) {
+	switch v := arg.Value.(type) {
+	case *filtererpb.Argument_NullValue:
+		return nil, nil
+	case *filtererpb.Argument_StringValue:
+		return v.StringValue, nil
+	case *filtererpb.Argument_Int64Value:
+		return v.Int64Value, nil
+	case *filtererpb.Argument_Uint64Value:
+		return v.Uint64Value, nil
+	case *filtererpb.Argument_DoubleValue:
+		return v.DoubleValue, nil
+	case *filtererpb.Argument_BoolValue:
+		return v.BoolValue, nil
+	case *filtererpb.Argument_BytesValue:
+		return v.BytesValue, nil
+	case *filtererpb.Argument_TimestampValue:
+		if err := v.TimestampValue.CheckValid(); err != nil {
+			return nil, fmt.Errorf("filterer: %w", err)
+		}
+		return v.TimestampValue.AsTime(), nil
+	default:
+		return nil, fmt.Errorf("filterer: unsupported argument of type %T", arg.Value)
+	}
+}
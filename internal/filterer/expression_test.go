@@ -0,0 +1,189 @@
+package filterer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/lopezator/filterer/internal/expr"
+	filtererpb "github.com/lopezator/filterer/proto/lopezator/filterer/v1"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func opNode(field string, ftype filtererpb.FieldType, op string, args ...*filtererpb.Argument) *filtererpb.Node {
+	return &filtererpb.Node{Node: &filtererpb.Node_Op{Op: &filtererpb.OperationNode{
+		Left: &filtererpb.Node{Node: &filtererpb.Node_Field{Field: &filtererpb.FieldNode{Name: field, Type: ftype}}},
+		Op:   op,
+		Args: args,
+	}}}
+}
+
+func notNode(node *filtererpb.Node) *filtererpb.Node {
+	return &filtererpb.Node{Node: &filtererpb.Node_Not{Not: node}}
+}
+
+func stringArgument(v string) *filtererpb.Argument {
+	return &filtererpb.Argument{Value: &filtererpb.Argument_StringValue{StringValue: v}}
+}
+
+func int64Argument(v int64) *filtererpb.Argument {
+	return &filtererpb.Argument{Value: &filtererpb.Argument_Int64Value{Int64Value: v}}
+}
+
+func TestFilterExpression(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, []*FieldSet{
+		{ID: "users", Fields: []*Field{
+			{Name: "name", Type: "string", Column: "u.display_name"},
+			{Name: "age", Type: "integer"},
+			{Name: "active", Type: "bool"},
+			{Name: "created_at", Type: "timestamp"},
+			{Name: "tags", Type: "string_array"},
+		}},
+	})
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "logical operators", input: "active && (name.startsWith('A') || !(age in [1, 2]))"},
+		{name: "null", input: "name != null"},
+		{name: "timestamps", input: "created_at < timestamp('2024-01-01T00:00:00.5Z')"},
+		{name: "present and size", input: "present(created_at) && size(tags) > 1"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			validated, err := client.Validate(context.Background(), connect.NewRequest(&filtererpb.ValidateRequest{
+				FieldSetId: "users",
+				Expr:       tt.input,
+			}))
+			if err != nil {
+				t.Fatalf("Validate() error: %v", err)
+			}
+			want, err := client.Filter(context.Background(), connect.NewRequest(&filtererpb.FilterRequest{
+				FieldSetId: "users",
+				Expr:       tt.input,
+			}))
+			if err != nil {
+				t.Fatalf("Filter() error: %v", err)
+			}
+			got, err := client.Filter(context.Background(), connect.NewRequest(&filtererpb.FilterRequest{
+				FieldSetId: "users",
+				Expression: validated.Msg.Expression,
+			}))
+			if err != nil {
+				t.Fatalf("Filter() error: %v", err)
+			}
+			if !proto.Equal(got.Msg, want.Msg) {
+				t.Errorf("Filter() got: %v, want %v", got.Msg, want.Msg)
+			}
+		})
+	}
+}
+
+func TestFilterExpressionError(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, []*FieldSet{
+		{ID: "users", Fields: []*Field{{Name: "name", Type: "string"}}},
+	})
+
+	tests := []struct {
+		name  string
+		input *filtererpb.Expression
+	}{
+		{
+			name:  "unknown field",
+			input: &filtererpb.Expression{Root: opNode("email", filtererpb.FieldType_FIELD_TYPE_STRING, "==", stringArgument("a"))},
+		},
+		{
+			name:  "field type mismatch",
+			input: &filtererpb.Expression{Root: opNode("name", filtererpb.FieldType_FIELD_TYPE_INTEGER, "==", int64Argument(1))},
+		},
+		{
+			name:  "missing node",
+			input: &filtererpb.Expression{Root: &filtererpb.Node{}},
+		},
+		{
+			name: "missing operand",
+			input: &filtererpb.Expression{Root: &filtererpb.Node{Node: &filtererpb.Node_And{And: &filtererpb.BinaryNode{
+				Left: opNode("name", filtererpb.FieldType_FIELD_TYPE_STRING, "==", stringArgument("a")),
+			}}}},
+		},
+		{
+			name: "invalid timestamp",
+			input: &filtererpb.Expression{Root: opNode("name", filtererpb.FieldType_FIELD_TYPE_STRING, "==", &filtererpb.Argument{
+				Value: &filtererpb.Argument_TimestampValue{TimestampValue: &timestamppb.Timestamp{Nanos: -1}},
+			})},
+		},
+		{
+			name:  "missing argument",
+			input: &filtererpb.Expression{Root: opNode("name", filtererpb.FieldType_FIELD_TYPE_STRING, "startsWith")},
+		},
+		{
+			name:  "argument of another type",
+			input: &filtererpb.Expression{Root: opNode("name", filtererpb.FieldType_FIELD_TYPE_STRING, "startsWith", int64Argument(1))},
+		},
+		{
+			name:  "too many arguments",
+			input: &filtererpb.Expression{Root: opNode("name", filtererpb.FieldType_FIELD_TYPE_STRING, "==", stringArgument("a"), stringArgument("b"))},
+		},
+		{
+			name:  "no arguments",
+			input: &filtererpb.Expression{Root: opNode("name", filtererpb.FieldType_FIELD_TYPE_STRING, "==")},
+		},
+		{
+			name:  "unknown operator",
+			input: &filtererpb.Expression{Root: opNode("name", filtererpb.FieldType_FIELD_TYPE_STRING, "~=", stringArgument("a"))},
+		},
+		{
+			name:  "depth limit",
+			input: &filtererpb.Expression{Root: notNode(notNode(notNode(notNode(notNode(notNode(opNode("name", filtererpb.FieldType_FIELD_TYPE_STRING, "==", stringArgument("a"))))))))},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := client.Filter(context.Background(), connect.NewRequest(&filtererpb.FilterRequest{
+				FieldSetId: "users",
+				Expression: tt.input,
+			}))
+			var connectErr *connect.Error
+			if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeInvalidArgument {
+				t.Errorf("Filter() error: %v, want code %v", err, connect.CodeInvalidArgument)
+			}
+		})
+	}
+}
+
+func TestExpressionCaseSensitive(t *testing.T) {
+	t.Parallel()
+
+	stringType, err := stringToType("string")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	parser, err := expr.NewParser(map[string]*exprpb.Type{"name": stringType})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	filter, err := parser.Build(expr.ExactEq("name", "Foo"))
+	if err != nil {
+		t.Fatalf("Build() error: %v", err)
+	}
+
+	// case sensitive comparisons have no representation, so they'd be
+	// converted back as case insensitive
+	if got, err := expression(filter); err == nil {
+		t.Errorf("expression() got: %v, want error", got)
+	}
+}
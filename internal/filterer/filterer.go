@@ -6,55 +6,118 @@ import (
 	"fmt"
 	"net/http"
 
-	"buf.build/gen/go/lopezator/filterer/connectrpc/go/lopezator/filterer/v1/filtererv1connect"
-	filtererpb "buf.build/gen/go/lopezator/filterer/protocolbuffers/go/lopezator/filterer/v1"
 	"connectrpc.com/connect"
 	"github.com/lopezator/filterer/internal/expr"
+	filtererpb "github.com/lopezator/filterer/proto/lopezator/filterer/v1"
+	"github.com/lopezator/filterer/proto/lopezator/filterer/v1/filtererv1connect"
 	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 )
 
 // Service is the filterer service implementation.
 type Service struct {
 	filtererv1connect.UnimplementedFiltererServiceHandler
-	parser *expr.Parser
+	fieldSetIDs []string
+	parsers     map[string]*expr.Parser
+	defaults    map[string][]*parsedDefault
+	scopes      map[string][]*Scope
 }
 
-// FieldSet is a set of filterable fields.
+// FieldSet is a set of filterable fields. Defaults are predicates applied to
+// every expression filtered, while scopes restrict them to the rows the caller
+// has access to.
 type FieldSet struct {
-	ID     string
-	Fields []*Field
+	ID       string
+	Fields   []*Field
+	Defaults []*Default
+	Scopes   []*Scope
 }
 
-// Field is the representation of a filterable field.
+// Field is the representation of a filterable field. Column optionally maps
+// the field to a SQL column other than the one named after it.
 type Field struct {
-	Name string
-	Type string
+	Name   string
+	Type   string
+	Column string
+}
+
+// dialectLookup maps the protobuf dialects to their expr implementation.
+var dialectLookup = map[filtererpb.Dialect]expr.Dialect{
+	filtererpb.Dialect_DIALECT_UNSPECIFIED: expr.DefaultDialect,
+	filtererpb.Dialect_DIALECT_POSTGRESQL:  expr.PostgreSQL,
+	filtererpb.Dialect_DIALECT_MYSQL:       expr.MySQL,
+	filtererpb.Dialect_DIALECT_SQLITE:      expr.SQLite,
+	filtererpb.Dialect_DIALECT_SQLSERVER:   expr.SQLServer,
+	filtererpb.Dialect_DIALECT_COCKROACHDB: expr.CockroachDB,
+}
+
+// syntaxLookup maps the protobuf syntaxes to the expr function parsing them.
+var syntaxLookup = map[filtererpb.Syntax]func(*expr.Parser, string) (*expr.Expr, error){
+	filtererpb.Syntax_SYNTAX_UNSPECIFIED: (*expr.Parser).Parse,
+	filtererpb.Syntax_SYNTAX_CEL:         (*expr.Parser).Parse,
+	filtererpb.Syntax_SYNTAX_AIP160:      (*expr.Parser).ParseAIP,
+	filtererpb.Syntax_SYNTAX_MONGODB:     (*expr.Parser).ParseMongo,
+	filtererpb.Syntax_SYNTAX_SCIM:        (*expr.Parser).ParseSCIM,
 }
 
 // NewService returns a service instance.
 func NewService(fieldSets []*FieldSet) (string, http.Handler) {
-	// Convert fieldSets to a map of string to exprpb.Type
-	var err error
-	fieldMap := make(map[string]*exprpb.Type)
+	// Create a parser per field set, so fields of different field sets never
+	// share the same namespace.
+	fieldSetIDs := make([]string, 0, len(fieldSets))
+	parsers := make(map[string]*expr.Parser, len(fieldSets))
+	defaults := make(map[string][]*parsedDefault, len(fieldSets))
+	scopes := make(map[string][]*Scope, len(fieldSets))
 	for _, fieldSet := range fieldSets {
+		if _, ok := parsers[fieldSet.ID]; ok {
+			panic(fmt.Errorf("filterer: duplicated field set %q", fieldSet.ID))
+		}
+
+		// Convert fields to a map of string to exprpb.Type
+		var err error
+		fieldMap := make(map[string]*exprpb.Type, len(fieldSet.Fields))
+		columns := make(map[string]string)
 		for _, field := range fieldSet.Fields {
 			fieldMap[field.Name], err = stringToType(field.Type)
 			if err != nil {
 				panic(err)
 			}
+			if field.Column != "" {
+				columns[field.Name] = field.Column
+			}
 		}
-	}
 
-	// Create a new parser
-	parser, err := expr.NewParser(fieldMap)
-	if err != nil {
-		panic(err)
+		// Create a new parser
+		parsers[fieldSet.ID], err = expr.NewParser(fieldMap, expr.WithColumns(columns))
+		if err != nil {
+			panic(err)
+		}
+		defaults[fieldSet.ID], err = parseDefaults(parsers[fieldSet.ID], fieldSet.Defaults)
+		if err != nil {
+			panic(err)
+		}
+		if err := checkScopes(parsers[fieldSet.ID], fieldSet.Scopes); err != nil {
+			panic(err)
+		}
+		scopes[fieldSet.ID] = fieldSet.Scopes
+		fieldSetIDs = append(fieldSetIDs, fieldSet.ID)
 	}
 	return filtererv1connect.NewFiltererServiceHandler(&Service{
-		parser: parser,
+		fieldSetIDs: fieldSetIDs,
+		parsers:     parsers,
+		defaults:    defaults,
+		scopes:      scopes,
 	})
 }
 
+// parser returns the parser of the field set identified by the given id.
+func (s *Service) parser(fieldSetID string) (*expr.Parser, error) {
+	parser, ok := s.parsers[fieldSetID]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("filterer: unknown field set %q", fieldSetID))
+	}
+	return parser, nil
+}
+
 // StringToType converts a string representation of a type to its corresponding exprpb.Type.
 func stringToType(s string) (*exprpb.Type, error) {
 	switch s {
@@ -113,20 +176,68 @@ func stringToType(s string) (*exprpb.Type, error) {
 
 // Filter implements filterer.FiltererServiceServer.Filter.
 func (s *Service) Filter(ctx context.Context, req *connect.Request[filtererpb.FilterRequest]) (*connect.Response[filtererpb.FilterResponse], error) {
-	// Parse the expression.
-	filter, err := s.parser.Parse(req.Msg.Expr)
+	// Select the parser of the requested field set.
+	parser, err := s.parser(req.Msg.FieldSetId)
 	if err != nil {
-		return nil, fmt.Errorf("filterer: %w", err)
+		return nil, err
+	}
+
+	// Parse the expression, unless already parsed.
+	var filter *expr.Expr
+	if req.Msg.Expression != nil {
+		filter, err = parsedExpression(parser, req.Msg.Expression)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	} else {
+		parse, ok := syntaxLookup[req.Msg.Syntax]
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("filterer: unknown syntax %v", req.Msg.Syntax))
+		}
+		filter, err = parse(parser, req.Msg.Expr)
+		if err != nil {
+			return nil, exprError(err)
+		}
+	}
+
+	// Apply the defaults of the field set, and restrict the expression to the
+	// scope of the caller.
+	filter, err = s.defaulted(req.Msg.FieldSetId, filter)
+	if err != nil {
+		return nil, err
+	}
+	filter, err = s.scoped(ctx, req.Header(), req.Msg.FieldSetId, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Select the dialect.
+	dialect, ok := dialectLookup[req.Msg.Dialect]
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("filterer: unknown dialect %v", req.Msg.Dialect))
+	}
+
+	// An empty expression, with no defaults nor scopes applied, doesn't
+	// restrict any row, so there's no clause to generate.
+	if filter.IsZero() {
+		return connect.NewResponse(&filtererpb.FilterResponse{}), nil
 	}
 
 	// Generate SQL clause.
-	clause, args, err := expr.SQL(filter)
+	clause, args, err := expr.SQL(filter, expr.WithDialect(dialect))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("filterer: %w", err))
+	}
+
+	// Convert args to their typed representation.
+	pbArgs, err := arguments(args)
 	if err != nil {
 		return nil, fmt.Errorf("filterer: %w", err)
 	}
 
 	// Return response.
 	return connect.NewResponse(&filtererpb.FilterResponse{
-		Where: fmt.Sprintf("WHERE: %s, ARGS: %v", clause, args),
+		Where: clause,
+		Args:  pbArgs,
 	}), nil
 }
@@ -0,0 +1,259 @@
+package filterer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	filtererpb "github.com/lopezator/filterer/proto/lopezator/filterer/v1"
+	"github.com/lopezator/filterer/proto/lopezator/filterer/v1/filtererv1connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestClient(t *testing.T, fieldSets []*FieldSet) filtererv1connect.FiltererServiceClient {
+	mux := http.NewServeMux()
+	mux.Handle(NewService(fieldSets))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return filtererv1connect.NewFiltererServiceClient(srv.Client(), srv.URL)
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, []*FieldSet{
+		{ID: "users", Fields: []*Field{{Name: "name", Type: "string", Column: "u.display_name"}}},
+		{ID: "orders", Fields: []*Field{{Name: "name", Type: "integer"}, {Name: "total", Type: "integer"}}},
+		{ID: "companies", Fields: []*Field{{Name: "company.name", Type: "string"}}},
+	})
+
+	tests := []struct {
+		name       string
+		fieldSetID string
+		input      string
+		dialect    filtererpb.Dialect
+		syntax     filtererpb.Syntax
+		want       *filtererpb.FilterResponse
+		wantCode   connect.Code
+	}{
+		{
+			name:       "field of the requested field set",
+			fieldSetID: "users",
+			input:      "name == 'A'",
+			want: &filtererpb.FilterResponse{
+				Where: `LOWER("u"."display_name") = (LOWER($1))`,
+				Args:  []*filtererpb.Argument{{Value: &filtererpb.Argument_StringValue{StringValue: "A"}}},
+			},
+		},
+		{
+			name:       "same field name with a different type in another field set",
+			fieldSetID: "orders",
+			input:      "name == 1 && total in [2, 3]",
+			dialect:    filtererpb.Dialect_DIALECT_SQLSERVER,
+			want: &filtererpb.FilterResponse{
+				Where: "([name] = (@p1) AND [total] IN (@p2,@p3))",
+				Args: []*filtererpb.Argument{
+					{Value: &filtererpb.Argument_Int64Value{Int64Value: 1}},
+					{Value: &filtererpb.Argument_Int64Value{Int64Value: 2}},
+					{Value: &filtererpb.Argument_Int64Value{Int64Value: 3}},
+				},
+			},
+		},
+		{
+			name:       "disallow fields of another field set",
+			fieldSetID: "users",
+			input:      "total == 1",
+			wantCode:   connect.CodeInvalidArgument,
+		},
+		{
+			name:       "aip160 syntax",
+			fieldSetID: "orders",
+			input:      "name = 1 AND total > 2 OR total < 1",
+			syntax:     filtererpb.Syntax_SYNTAX_AIP160,
+			want: &filtererpb.FilterResponse{
+				Where: `("name" = ($1) AND ("total" > ($2) OR "total" < ($3)))`,
+				Args: []*filtererpb.Argument{
+					{Value: &filtererpb.Argument_Int64Value{Int64Value: 1}},
+					{Value: &filtererpb.Argument_Int64Value{Int64Value: 2}},
+					{Value: &filtererpb.Argument_Int64Value{Int64Value: 1}},
+				},
+			},
+		},
+		{
+			name:       "invalid aip160 expression",
+			fieldSetID: "orders",
+			input:      "name == 1",
+			syntax:     filtererpb.Syntax_SYNTAX_AIP160,
+			wantCode:   connect.CodeInvalidArgument,
+		},
+		{
+			name:       "mongodb syntax",
+			fieldSetID: "orders",
+			input:      `{"name": 1, "$or": [{"total": {"$gt": 2}}, {"total": {"$lt": 1}}]}`,
+			syntax:     filtererpb.Syntax_SYNTAX_MONGODB,
+			want: &filtererpb.FilterResponse{
+				Where: `("name" = ($1) AND ("total" > ($2) OR "total" < ($3)))`,
+				Args: []*filtererpb.Argument{
+					{Value: &filtererpb.Argument_Int64Value{Int64Value: 1}},
+					{Value: &filtererpb.Argument_Int64Value{Int64Value: 2}},
+					{Value: &filtererpb.Argument_Int64Value{Int64Value: 1}},
+				},
+			},
+		},
+		{
+			name:       "invalid mongodb expression",
+			fieldSetID: "orders",
+			input:      `{"name": {"$mod": [2, 0]}}`,
+			syntax:     filtererpb.Syntax_SYNTAX_MONGODB,
+			wantCode:   connect.CodeInvalidArgument,
+		},
+		{
+			name:       "scim syntax",
+			fieldSetID: "orders",
+			input:      "name eq 1 and (total gt 2 or total lt 1)",
+			syntax:     filtererpb.Syntax_SYNTAX_SCIM,
+			want: &filtererpb.FilterResponse{
+				Where: `("name" = ($1) AND ("total" > ($2) OR "total" < ($3)))`,
+				Args: []*filtererpb.Argument{
+					{Value: &filtererpb.Argument_Int64Value{Int64Value: 1}},
+					{Value: &filtererpb.Argument_Int64Value{Int64Value: 2}},
+					{Value: &filtererpb.Argument_Int64Value{Int64Value: 1}},
+				},
+			},
+		},
+		{
+			name:       "invalid scim expression",
+			fieldSetID: "orders",
+			input:      "name == 1",
+			syntax:     filtererpb.Syntax_SYNTAX_SCIM,
+			wantCode:   connect.CodeInvalidArgument,
+		},
+		{
+			name:       "empty expression",
+			fieldSetID: "orders",
+			want:       &filtererpb.FilterResponse{},
+		},
+		{
+			name:       "empty mongodb expression",
+			fieldSetID: "orders",
+			input:      "{}",
+			syntax:     filtererpb.Syntax_SYNTAX_MONGODB,
+			want:       &filtererpb.FilterResponse{},
+		},
+		{
+			name:       "empty aip160 expression",
+			fieldSetID: "orders",
+			syntax:     filtererpb.Syntax_SYNTAX_AIP160,
+			want:       &filtererpb.FilterResponse{},
+		},
+		{
+			name:       "empty scim expression",
+			fieldSetID: "orders",
+			syntax:     filtererpb.Syntax_SYNTAX_SCIM,
+			want:       &filtererpb.FilterResponse{},
+		},
+		{
+			name:       "unknown syntax",
+			fieldSetID: "orders",
+			input:      "name == 1",
+			syntax:     filtererpb.Syntax(-1),
+			wantCode:   connect.CodeInvalidArgument,
+		},
+		{
+			name:       "empty list",
+			fieldSetID: "orders",
+			input:      "total in []",
+			wantCode:   connect.CodeInvalidArgument,
+		},
+		{
+			name:       "expression without SQL translation",
+			fieldSetID: "companies",
+			input:      "company.name in ['A', 'B']",
+			wantCode:   connect.CodeInvalidArgument,
+		},
+		{
+			name:       "unknown field set",
+			fieldSetID: "invoices",
+			input:      "name == 'A'",
+			wantCode:   connect.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := client.Filter(context.Background(), connect.NewRequest(&filtererpb.FilterRequest{
+				FieldSetId: tt.fieldSetID,
+				Expr:       tt.input,
+				Dialect:    tt.dialect,
+				Syntax:     tt.syntax,
+			}))
+			var connectErr *connect.Error
+			switch {
+			case tt.wantCode == 0 && err != nil:
+				t.Errorf("Filter() error: %v", err)
+			case tt.wantCode != 0 && (!errors.As(err, &connectErr) || connectErr.Code() != tt.wantCode):
+				t.Errorf("Filter() error: %v, want code %v", err, tt.wantCode)
+			case tt.want != nil && !proto.Equal(got.Msg, tt.want):
+				t.Errorf("Filter() got: %v, want %v", got.Msg, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterErrorDetails(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, []*FieldSet{
+		{ID: "users", Fields: []*Field{{Name: "email", Type: "string"}, {Name: "age", Type: "integer"}}},
+	})
+
+	_, err := client.Filter(context.Background(), connect.NewRequest(&filtererpb.FilterRequest{
+		FieldSetId: "users",
+		Expr:       "age > 1 && emial == 'a'",
+	}))
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeInvalidArgument {
+		t.Fatalf("Filter() error: %v, want code %v", err, connect.CodeInvalidArgument)
+	}
+
+	var gotBadRequest, gotErrorInfo bool
+	for _, detail := range connectErr.Details() {
+		value, err := detail.Value()
+		if err != nil {
+			t.Fatalf("Details() error: %v", err)
+		}
+		switch value := value.(type) {
+		case *errdetails.BadRequest:
+			gotBadRequest = true
+			if len(value.FieldViolations) != 1 || value.FieldViolations[0].Field != "expr" {
+				t.Errorf("BadRequest got: %v, want a violation of expr", value)
+			}
+		case *errdetails.ErrorInfo:
+			gotErrorInfo = true
+			want := &errdetails.ErrorInfo{
+				Reason: "UNKNOWN_FIELD",
+				Domain: errorDomain,
+				Metadata: map[string]string{
+					"offset":      "11",
+					"end":         "16",
+					"line":        "1",
+					"column":      "12",
+					"field":       "emial",
+					"suggestions": "email",
+				},
+			}
+			if !proto.Equal(value, want) {
+				t.Errorf("ErrorInfo got: %v, want %v", value, want)
+			}
+		}
+	}
+	if !gotBadRequest || !gotErrorInfo {
+		t.Errorf("Details() got BadRequest %v and ErrorInfo %v, want both", gotBadRequest, gotErrorInfo)
+	}
+}
@@ -0,0 +1,60 @@
+package filterer
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/lopezator/filterer/internal/expr"
+	filtererpb "github.com/lopezator/filterer/proto/lopezator/filterer/v1"
+)
+
+// fieldTypeLookup maps the expr field types to their protobuf representation.
+var fieldTypeLookup = map[expr.FieldType]filtererpb.FieldType{
+	expr.BoolFieldType:        filtererpb.FieldType_FIELD_TYPE_BOOL,
+	expr.IntegerFieldType:     filtererpb.FieldType_FIELD_TYPE_INTEGER,
+	expr.DoubleFieldType:      filtererpb.FieldType_FIELD_TYPE_DOUBLE,
+	expr.StringFieldType:      filtererpb.FieldType_FIELD_TYPE_STRING,
+	expr.BytesFieldType:       filtererpb.FieldType_FIELD_TYPE_BYTES,
+	expr.TimestampFieldType:   filtererpb.FieldType_FIELD_TYPE_TIMESTAMP,
+	expr.StringArrayFieldType: filtererpb.FieldType_FIELD_TYPE_STRING_ARRAY,
+}
+
+// ListFieldSets implements filterer.FiltererServiceServer.ListFieldSets.
+func (s *Service) ListFieldSets(ctx context.Context, req *connect.Request[filtererpb.ListFieldSetsRequest]) (*connect.Response[filtererpb.ListFieldSetsResponse], error) {
+	fieldSets := make([]*filtererpb.FieldSet, len(s.fieldSetIDs))
+	for i, fieldSetID := range s.fieldSetIDs {
+		fieldSets[i] = fieldSet(fieldSetID, s.parsers[fieldSetID])
+	}
+	return connect.NewResponse(&filtererpb.ListFieldSetsResponse{
+		FieldSets: fieldSets,
+	}), nil
+}
+
+// DescribeFieldSet implements filterer.FiltererServiceServer.DescribeFieldSet.
+func (s *Service) DescribeFieldSet(ctx context.Context, req *connect.Request[filtererpb.DescribeFieldSetRequest]) (*connect.Response[filtererpb.DescribeFieldSetResponse], error) {
+	parser, err := s.parser(req.Msg.FieldSetId)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&filtererpb.DescribeFieldSetResponse{
+		FieldSet: fieldSet(req.Msg.FieldSetId, parser),
+	}), nil
+}
+
+// fieldSet describes the field set checked by the given parser, so it's
+// always consistent with the expressions it accepts.
+func fieldSet(id string, parser *expr.Parser) *filtererpb.FieldSet {
+	fields := parser.Fields()
+	pbFields := make([]*filtererpb.Field, len(fields))
+	for i, field := range fields {
+		pbFields[i] = &filtererpb.Field{
+			Name:      field.Name,
+			Type:      fieldTypeLookup[field.Ftype],
+			Operators: parser.Operators(field.Ftype),
+		}
+	}
+	return &filtererpb.FieldSet{
+		Id:     id,
+		Fields: pbFields,
+	}
+}
@@ -0,0 +1,111 @@
+package filterer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	filtererpb "github.com/lopezator/filterer/proto/lopezator/filterer/v1"
+	"github.com/lopezator/filterer/proto/lopezator/filterer/v1/filtererv1connect"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	testUsersFieldSet = &filtererpb.FieldSet{
+		Id: "users",
+		Fields: []*filtererpb.Field{
+			{
+				Name:      "name",
+				Type:      filtererpb.FieldType_FIELD_TYPE_STRING,
+				Operators: []string{"==", "!=", "in", "contains", "endsWith", "startsWith", "present"},
+			},
+			{
+				Name:      "tags",
+				Type:      filtererpb.FieldType_FIELD_TYPE_STRING_ARRAY,
+				Operators: []string{"contains", "size"},
+			},
+		},
+	}
+	testOrdersFieldSet = &filtererpb.FieldSet{
+		Id: "orders",
+		Fields: []*filtererpb.Field{
+			{
+				Name:      "paid",
+				Type:      filtererpb.FieldType_FIELD_TYPE_BOOL,
+				Operators: []string{"==", "present"},
+			},
+			{
+				Name:      "total",
+				Type:      filtererpb.FieldType_FIELD_TYPE_DOUBLE,
+				Operators: []string{"==", "!=", "in", "<", "<=", ">", ">=", "present"},
+			},
+		},
+	}
+)
+
+func newSchemaTestClient(t *testing.T) filtererv1connect.FiltererServiceClient {
+	return newTestClient(t, []*FieldSet{
+		{ID: "users", Fields: []*Field{{Name: "tags", Type: "string_array"}, {Name: "name", Type: "string", Column: "u.display_name"}}},
+		{ID: "orders", Fields: []*Field{{Name: "total", Type: "double"}, {Name: "paid", Type: "bool"}}},
+	})
+}
+
+func TestListFieldSets(t *testing.T) {
+	t.Parallel()
+
+	client := newSchemaTestClient(t)
+	got, err := client.ListFieldSets(context.Background(), connect.NewRequest(&filtererpb.ListFieldSetsRequest{}))
+	if err != nil {
+		t.Fatalf("ListFieldSets() error: %v", err)
+	}
+	want := &filtererpb.ListFieldSetsResponse{
+		FieldSets: []*filtererpb.FieldSet{testUsersFieldSet, testOrdersFieldSet},
+	}
+	if !proto.Equal(got.Msg, want) {
+		t.Errorf("ListFieldSets() got: %v, want %v", got.Msg, want)
+	}
+}
+
+func TestDescribeFieldSet(t *testing.T) {
+	t.Parallel()
+
+	client := newSchemaTestClient(t)
+
+	tests := []struct {
+		name       string
+		fieldSetID string
+		want       *filtererpb.DescribeFieldSetResponse
+		wantCode   connect.Code
+	}{
+		{
+			name:       "known field set",
+			fieldSetID: "orders",
+			want:       &filtererpb.DescribeFieldSetResponse{FieldSet: testOrdersFieldSet},
+		},
+		{
+			name:       "unknown field set",
+			fieldSetID: "invoices",
+			wantCode:   connect.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := client.DescribeFieldSet(context.Background(), connect.NewRequest(&filtererpb.DescribeFieldSetRequest{
+				FieldSetId: tt.fieldSetID,
+			}))
+			var connectErr *connect.Error
+			switch {
+			case tt.wantCode == 0 && err != nil:
+				t.Errorf("DescribeFieldSet() error: %v", err)
+			case tt.wantCode != 0 && (!errors.As(err, &connectErr) || connectErr.Code() != tt.wantCode):
+				t.Errorf("DescribeFieldSet() error: %v, want code %v", err, tt.wantCode)
+			case tt.want != nil && !proto.Equal(got.Msg, tt.want):
+				t.Errorf("DescribeFieldSet() got: %v, want %v", got.Msg, tt.want)
+			}
+		})
+	}
+}
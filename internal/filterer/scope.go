@@ -0,0 +1,169 @@
+package filterer
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/lopezator/filterer/internal/expr"
+)
+
+// Scope binds a field to a value of the request metadata, either a header or
+// a claim of the caller, so only the rows whose field equals that value are
+// filtered. Repeated headers and list claims bind the field to any of their
+// values.
+type Scope struct {
+	Field  string
+	Header string
+	Claim  string
+}
+
+type claimsKey struct{}
+
+// WithClaims returns a copy of the context carrying the claims of the
+// authenticated caller, to be set by the authentication middleware in front
+// of the service for scopes binding claims.
+func WithClaims(ctx context.Context, claims map[string]any) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// claimsFromContext returns the claims of the caller carried by the context.
+func claimsFromContext(ctx context.Context) map[string]any {
+	claims, _ := ctx.Value(claimsKey{}).(map[string]any)
+	return claims
+}
+
+// checkScopes checks the scopes bind known fields to a single source.
+func checkScopes(parser *expr.Parser, scopes []*Scope) error {
+	for _, scope := range scopes {
+		field, ok := parser.Field(scope.Field)
+		if !ok {
+			return fmt.Errorf("filterer: unknown scope field %q", scope.Field)
+		}
+		if !containsOperator(parser.Operators(field.Ftype), expr.OperatorEquals) {
+			return fmt.Errorf("filterer: unsupported scope field %q of type %s", scope.Field, field.Ftype)
+		}
+		if (scope.Header == "") == (scope.Claim == "") {
+			return fmt.Errorf("filterer: scope of field %q must bind either a header or a claim", scope.Field)
+		}
+	}
+	return nil
+}
+
+func containsOperator(ops []string, op string) bool {
+	for _, o := range ops {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
+
+// scoped returns the expression restricted to the scope of the caller, that
+// is ANDed with the conditions of the scopes of the field set, so no clause
+// of the expression can bypass them. Callers without the values scopes bind
+// are denied.
+func (s *Service) scoped(ctx context.Context, header http.Header, fieldSetID string, filter *expr.Expr) (*expr.Expr, error) {
+	scopes := s.scopes[fieldSetID]
+	if len(scopes) == 0 {
+		return filter, nil
+	}
+	parser := s.parsers[fieldSetID]
+	claims := claimsFromContext(ctx)
+	conds := make([]expr.Cond, len(scopes))
+	for i, scope := range scopes {
+		var values []any
+		var source string
+		if scope.Header != "" {
+			source = fmt.Sprintf("header %s", scope.Header)
+			// a header binds a single value, as only list claims are
+			// trusted to widen the scope
+			headerValues := header.Values(scope.Header)
+			if len(headerValues) > 1 {
+				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("filterer: repeated %s", source))
+			}
+			for _, value := range headerValues {
+				values = append(values, value)
+			}
+		} else {
+			source = fmt.Sprintf("claim %s", scope.Claim)
+			switch claim := claims[scope.Claim].(type) {
+			case nil:
+			case []any:
+				values = claim
+			default:
+				values = []any{claim}
+			}
+		}
+		// empty values are missing too, as they'd scope the caller to the
+		// rows of no tenant
+		if len(values) == 0 || containsEmpty(values) {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("filterer: missing %s", source))
+		}
+
+		field, _ := parser.Field(scope.Field)
+		for j, value := range values {
+			var err error
+			if values[j], err = scopeValue(value, field.Ftype); err != nil {
+				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("filterer: invalid %s: %w", source, err))
+			}
+		}
+		// scopes isolate tenants, so their strings are compared exactly
+		if len(values) == 1 {
+			conds[i] = expr.ExactEq(scope.Field, values[0])
+		} else {
+			conds[i] = expr.ExactIn(scope.Field, values...)
+		}
+	}
+	scope, err := parser.Build(expr.And(conds...))
+	if err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("filterer: invalid scope: %w", err))
+	}
+
+	if filter.IsZero() {
+		return scope, nil
+	}
+	return &expr.Expr{Root: &expr.AndExpr{Left: filter.Root, Right: scope.Root}}, nil
+}
+
+// containsEmpty reports whether any of the values is an empty string.
+func containsEmpty(values []any) bool {
+	for _, value := range values {
+		if s, ok := value.(string); ok && s == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// scopeValue converts the text of headers and claims, and the integral
+// numbers of JSON claims, to the type of the scoped field.
+func scopeValue(value any, ftype expr.FieldType) (any, error) {
+	switch v := value.(type) {
+	case float64:
+		if ftype == expr.IntegerFieldType {
+			if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+				return nil, fmt.Errorf("value %v isn't an integer", v)
+			}
+			return int64(v), nil
+		}
+	case string:
+		switch ftype {
+		case expr.BoolFieldType:
+			return strconv.ParseBool(v)
+		case expr.IntegerFieldType:
+			return strconv.ParseInt(v, 10, 64)
+		case expr.DoubleFieldType:
+			return strconv.ParseFloat(v, 64)
+		case expr.TimestampFieldType:
+			return time.Parse(time.RFC3339, v)
+		default:
+			return v, nil
+		}
+	}
+	return value, nil
+}
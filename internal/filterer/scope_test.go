@@ -0,0 +1,257 @@
+package filterer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	filtererpb "github.com/lopezator/filterer/proto/lopezator/filterer/v1"
+	"github.com/lopezator/filterer/proto/lopezator/filterer/v1/filtererv1connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// newScopedTestClient returns a client of a service whose callers are
+// authenticated with the claims found in the X-Test-Claims header.
+func newScopedTestClient(t *testing.T, fieldSets []*FieldSet) filtererv1connect.FiltererServiceClient {
+	path, handler := NewService(fieldSets)
+	mux := http.NewServeMux()
+	mux.Handle(path, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if header := r.Header.Get("X-Test-Claims"); header != "" {
+			var claims map[string]any
+			if err := json.Unmarshal([]byte(header), &claims); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			r = r.WithContext(WithClaims(r.Context(), claims))
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return filtererv1connect.NewFiltererServiceClient(srv.Client(), srv.URL)
+}
+
+func TestFilterScopes(t *testing.T) {
+	t.Parallel()
+
+	client := newScopedTestClient(t, []*FieldSet{
+		{
+			ID:     "users",
+			Fields: []*Field{{Name: "name", Type: "string"}, {Name: "tenant_id", Type: "integer"}},
+			Scopes: []*Scope{{Field: "tenant_id", Header: "X-Tenant-Id"}},
+		},
+		{
+			ID:     "orders",
+			Fields: []*Field{{Name: "total", Type: "integer"}, {Name: "org", Type: "string"}, {Name: "owner_id", Type: "integer"}},
+			Scopes: []*Scope{{Field: "org", Claim: "orgs"}, {Field: "owner_id", Claim: "sub"}},
+		},
+		{
+			ID:     "projects",
+			Fields: []*Field{{Name: "name", Type: "string"}, {Name: "tenant", Type: "string"}},
+			Scopes: []*Scope{{Field: "tenant", Header: "X-Tenant"}},
+		},
+	})
+
+	tests := []struct {
+		name       string
+		fieldSetID string
+		input      string
+		headers    map[string][]string
+		want       *filtererpb.FilterResponse
+		wantCode   connect.Code
+	}{
+		{
+			name:       "header scope",
+			fieldSetID: "users",
+			input:      "name == 'a' || tenant_id == 2",
+			headers:    map[string][]string{"X-Tenant-Id": {"1"}},
+			want: &filtererpb.FilterResponse{
+				Where: `((LOWER("name") = (LOWER($1)) OR "tenant_id" = ($2)) AND "tenant_id" = ($3))`,
+				Args:  []*filtererpb.Argument{stringArgument("a"), int64Argument(2), int64Argument(1)},
+			},
+		},
+		{
+			name:       "empty expression",
+			fieldSetID: "users",
+			headers:    map[string][]string{"X-Tenant-Id": {"1"}},
+			want: &filtererpb.FilterResponse{
+				Where: `"tenant_id" = ($1)`,
+				Args:  []*filtererpb.Argument{int64Argument(1)},
+			},
+		},
+		{
+			name:       "claim scopes",
+			fieldSetID: "orders",
+			input:      "total > 10",
+			headers:    map[string][]string{"X-Test-Claims": {`{"orgs": ["a", "b"], "sub": 7}`}},
+			want: &filtererpb.FilterResponse{
+				Where: `("total" > ($1) AND ("org" IN ($2,$3) AND "owner_id" = ($4)))`,
+				Args:  []*filtererpb.Argument{int64Argument(10), stringArgument("a"), stringArgument("b"), int64Argument(7)},
+			},
+		},
+		{
+			name:       "missing header",
+			fieldSetID: "users",
+			input:      "name == 'a'",
+			wantCode:   connect.CodePermissionDenied,
+		},
+		{
+			name:       "string header scope",
+			fieldSetID: "projects",
+			input:      "name == 'a'",
+			headers:    map[string][]string{"X-Tenant": {"Acme"}},
+			want: &filtererpb.FilterResponse{
+				Where: `(LOWER("name") = (LOWER($1)) AND "tenant" = ($2))`,
+				Args:  []*filtererpb.Argument{stringArgument("a"), stringArgument("Acme")},
+			},
+		},
+		{
+			name:       "empty header",
+			fieldSetID: "projects",
+			input:      "name == 'a'",
+			headers:    map[string][]string{"X-Tenant": {""}},
+			wantCode:   connect.CodePermissionDenied,
+		},
+		{
+			name:       "empty claim",
+			fieldSetID: "orders",
+			input:      "total > 10",
+			headers:    map[string][]string{"X-Test-Claims": {`{"orgs": "", "sub": 7}`}},
+			wantCode:   connect.CodePermissionDenied,
+		},
+		{
+			name:       "empty claim element",
+			fieldSetID: "orders",
+			input:      "total > 10",
+			headers:    map[string][]string{"X-Test-Claims": {`{"orgs": ["a", ""], "sub": 7}`}},
+			wantCode:   connect.CodePermissionDenied,
+		},
+		{
+			name:       "repeated header",
+			fieldSetID: "users",
+			input:      "name == 'a'",
+			headers:    map[string][]string{"X-Tenant-Id": {"1", "2"}},
+			wantCode:   connect.CodePermissionDenied,
+		},
+		{
+			name:       "invalid header",
+			fieldSetID: "users",
+			input:      "name == 'a'",
+			headers:    map[string][]string{"X-Tenant-Id": {"acme"}},
+			wantCode:   connect.CodePermissionDenied,
+		},
+		{
+			name:       "missing claim",
+			fieldSetID: "orders",
+			input:      "total > 10",
+			headers:    map[string][]string{"X-Test-Claims": {`{"orgs": ["a"]}`}},
+			wantCode:   connect.CodePermissionDenied,
+		},
+		{
+			name:       "invalid claim",
+			fieldSetID: "orders",
+			input:      "total > 10",
+			headers:    map[string][]string{"X-Test-Claims": {`{"orgs": "a", "sub": 7.5}`}},
+			wantCode:   connect.CodePermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := connect.NewRequest(&filtererpb.FilterRequest{
+				FieldSetId: tt.fieldSetID,
+				Expr:       tt.input,
+			})
+			for key, values := range tt.headers {
+				for _, value := range values {
+					req.Header().Add(key, value)
+				}
+			}
+			got, err := client.Filter(context.Background(), req)
+			var connectErr *connect.Error
+			switch {
+			case tt.wantCode == 0 && err != nil:
+				t.Errorf("Filter() error: %v", err)
+			case tt.wantCode != 0 && (!errors.As(err, &connectErr) || connectErr.Code() != tt.wantCode):
+				t.Errorf("Filter() error: %v, want code %v", err, tt.wantCode)
+			case tt.want != nil && !proto.Equal(got.Msg, tt.want):
+				t.Errorf("Filter() got: %v, want %v", got.Msg, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateScopes(t *testing.T) {
+	t.Parallel()
+
+	client := newScopedTestClient(t, []*FieldSet{{
+		ID:     "users",
+		Fields: []*Field{{Name: "name", Type: "string"}, {Name: "tenant_id", Type: "integer"}},
+		Scopes: []*Scope{{Field: "tenant_id", Header: "X-Tenant-Id"}},
+	}})
+
+	var records []*structpb.Struct
+	for _, fields := range []map[string]any{
+		{"name": "a", "tenant_id": 1},
+		{"name": "a", "tenant_id": 2},
+		{"name": "b", "tenant_id": 1},
+	} {
+		record, err := structpb.NewStruct(fields)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		records = append(records, record)
+	}
+	req := connect.NewRequest(&filtererpb.EvaluateRequest{
+		FieldSetId: "users",
+		Expr:       "name == 'a' || tenant_id == 2",
+		Records:    records,
+	})
+	req.Header().Set("X-Tenant-Id", "1")
+	got, err := client.Evaluate(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Evaluate() error: %v", err)
+	}
+	want := &filtererpb.EvaluateResponse{Indices: []int32{0}}
+	if !proto.Equal(got.Msg, want) {
+		t.Errorf("Evaluate() got: %v, want %v", got.Msg, want)
+	}
+}
+
+func TestNewServiceScopes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		scopes []*Scope
+	}{
+		{name: "unknown field", scopes: []*Scope{{Field: "org", Header: "X-Org"}}},
+		{name: "unsupported field", scopes: []*Scope{{Field: "tags", Header: "X-Tags"}}},
+		{name: "no source", scopes: []*Scope{{Field: "tenant_id"}}},
+		{name: "both sources", scopes: []*Scope{{Field: "tenant_id", Header: "X-Tenant-Id", Claim: "tenant"}}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewService() didn't panic")
+				}
+			}()
+			NewService([]*FieldSet{{
+				ID:     "users",
+				Fields: []*Field{{Name: "tenant_id", Type: "integer"}, {Name: "tags", Type: "string_array"}},
+				Scopes: tt.scopes,
+			}})
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package filterer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/lopezator/filterer/internal/expr"
+	filtererpb "github.com/lopezator/filterer/proto/lopezator/filterer/v1"
+)
+
+// Validate implements filterer.FiltererServiceServer.Validate.
+func (s *Service) Validate(ctx context.Context, req *connect.Request[filtererpb.ValidateRequest]) (*connect.Response[filtererpb.ValidateResponse], error) {
+	// Select the parser of the requested field set.
+	parser, err := s.parser(req.Msg.FieldSetId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse the expression, reporting errors as diagnostics.
+	filter, warnings, err := parser.Lint(req.Msg.Expr)
+	if err != nil {
+		var exprErr *expr.Error
+		if !errors.As(err, &exprErr) {
+			return nil, fmt.Errorf("filterer: %w", err)
+		}
+		return connect.NewResponse(&filtererpb.ValidateResponse{
+			Diagnostics: []*filtererpb.Diagnostic{errorDiagnostic(exprErr)},
+		}), nil
+	}
+
+	// Make sure SQL can be generated, as some expressions are only rejected
+	// then, but never return it. Empty expressions need none.
+	if !filter.IsZero() {
+		if _, _, err := expr.SQL(filter); err != nil {
+			return connect.NewResponse(&filtererpb.ValidateResponse{
+				Diagnostics: []*filtererpb.Diagnostic{{
+					Severity: filtererpb.Severity_SEVERITY_ERROR,
+					Code:     strings.ToUpper(expr.UnsupportedErrorKind.String()),
+					Message:  err.Error(),
+				}},
+			}), nil
+		}
+	}
+
+	pbExpr, err := expression(filter)
+	if err != nil {
+		return nil, fmt.Errorf("filterer: %w", err)
+	}
+	canonical, err := expr.Format(filter)
+	if err != nil {
+		return nil, fmt.Errorf("filterer: %w", err)
+	}
+
+	// Return the warnings and the references of the valid expression.
+	diagnostics := make([]*filtererpb.Diagnostic, len(warnings))
+	for i, warning := range warnings {
+		diagnostics[i] = warningDiagnostic(warning)
+	}
+	return connect.NewResponse(&filtererpb.ValidateResponse{
+		Valid:         true,
+		Diagnostics:   diagnostics,
+		Fields:        filter.Fields(),
+		Operators:     filter.Operators(),
+		Expression:    pbExpr,
+		CanonicalExpr: canonical,
+	}), nil
+}
+
+// errorDiagnostic converts a parse error to its protobuf diagnostic.
+func errorDiagnostic(err *expr.Error) *filtererpb.Diagnostic {
+	diagnostic := &filtererpb.Diagnostic{
+		Severity:    filtererpb.Severity_SEVERITY_ERROR,
+		Code:        strings.ToUpper(err.Kind.String()),
+		Message:     err.Msg,
+		Field:       err.Field,
+		Operator:    err.Operator,
+		Suggestions: err.Suggestions,
+	}
+	if err.Offset >= 0 {
+		diagnostic.Span = span(err.Offset, err.End, err.Line, err.Column)
+	}
+	return diagnostic
+}
+
+// warningDiagnostic converts a lint warning to its protobuf diagnostic.
+func warningDiagnostic(warning *expr.Warning) *filtererpb.Diagnostic {
+	return &filtererpb.Diagnostic{
+		Severity: filtererpb.Severity_SEVERITY_WARNING,
+		Code:     strings.ToUpper(warning.Kind.String()),
+		Message:  warning.Msg,
+		Span:     span(warning.Offset, warning.End, warning.Line, warning.Column),
+		Field:    warning.Field,
+		Operator: warning.Operator,
+	}
+}
+
+// span returns the protobuf span of the given position.
+func span(start, end, line, column int) *filtererpb.Span {
+	return &filtererpb.Span{
+		Start:  int32(start),
+		End:    int32(end),
+		Line:   int32(line),
+		Column: int32(column),
+	}
+}
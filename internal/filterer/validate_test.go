@@ -0,0 +1,150 @@
+package filterer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	filtererpb "github.com/lopezator/filterer/proto/lopezator/filterer/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, []*FieldSet{
+		{ID: "users", Fields: []*Field{
+			{Name: "email", Type: "string"},
+			{Name: "age", Type: "integer"},
+			{Name: "address.tags", Type: "string_array"},
+		}},
+	})
+
+	tests := []struct {
+		name       string
+		fieldSetID string
+		input      string
+		want       *filtererpb.ValidateResponse
+		wantCode   connect.Code
+	}{
+		{
+			name:       "valid expression",
+			fieldSetID: "users",
+			input:      "age > 18 && (email.endsWith('@example.com') || !(age in [20, 30]))",
+			want: &filtererpb.ValidateResponse{
+				Valid:         true,
+				Fields:        []string{"age", "email"},
+				Operators:     []string{"&&", ">", "||", "endsWith", "!", "in"},
+				CanonicalExpr: `age > 18 && (email.endsWith("@example.com") || !(age in [20, 30]))`,
+				Expression: &filtererpb.Expression{Root: &filtererpb.Node{Node: &filtererpb.Node_And{And: &filtererpb.BinaryNode{
+					Left: opNode("age", filtererpb.FieldType_FIELD_TYPE_INTEGER, ">", int64Argument(18)),
+					Right: &filtererpb.Node{Node: &filtererpb.Node_Or{Or: &filtererpb.BinaryNode{
+						Left: opNode("email", filtererpb.FieldType_FIELD_TYPE_STRING, "endsWith", stringArgument("@example.com")),
+						Right: &filtererpb.Node{Node: &filtererpb.Node_Not{
+							Not: opNode("age", filtererpb.FieldType_FIELD_TYPE_INTEGER, "in", int64Argument(20), int64Argument(30)),
+						}},
+					}}},
+				}}}},
+			},
+		},
+		{
+			name:       "valid expression with warnings",
+			fieldSetID: "users",
+			input:      "email.startsWith('') && age in [1, 1]",
+			want: &filtererpb.ValidateResponse{
+				Valid: true,
+				Diagnostics: []*filtererpb.Diagnostic{
+					{
+						Severity: filtererpb.Severity_SEVERITY_WARNING,
+						Code:     "EMPTY_PATTERN",
+						Message:  "startsWith with an empty string matches any value",
+						Span:     &filtererpb.Span{Start: 17, End: 19, Line: 1, Column: 18},
+						Field:    "email",
+						Operator: "startsWith",
+					},
+					{
+						Severity: filtererpb.Severity_SEVERITY_WARNING,
+						Code:     "DUPLICATE_VALUE",
+						Message:  "duplicated value 1 in list",
+						Span:     &filtererpb.Span{Start: 35, End: 36, Line: 1, Column: 36},
+						Field:    "age",
+						Operator: "in",
+					},
+				},
+				Fields:        []string{"email", "age"},
+				Operators:     []string{"&&", "startsWith", "in"},
+				CanonicalExpr: `email.startsWith("") && age in [1, 1]`,
+				Expression: &filtererpb.Expression{Root: &filtererpb.Node{Node: &filtererpb.Node_And{And: &filtererpb.BinaryNode{
+					Left:  opNode("email", filtererpb.FieldType_FIELD_TYPE_STRING, "startsWith", stringArgument("")),
+					Right: opNode("age", filtererpb.FieldType_FIELD_TYPE_INTEGER, "in", int64Argument(1), int64Argument(1)),
+				}}}},
+			},
+		},
+		{
+			name:       "invalid expression",
+			fieldSetID: "users",
+			input:      "emal == 'a'",
+			want: &filtererpb.ValidateResponse{
+				Diagnostics: []*filtererpb.Diagnostic{{
+					Severity:    filtererpb.Severity_SEVERITY_ERROR,
+					Code:        "UNKNOWN_FIELD",
+					Message:     "unknown field emal",
+					Span:        &filtererpb.Span{Start: 0, End: 4, Line: 1, Column: 1},
+					Field:       "emal",
+					Suggestions: []string{"email"},
+				}},
+			},
+		},
+		{
+			name:       "expression without SQL translation",
+			fieldSetID: "users",
+			input:      "address.tags.contains('a')",
+			want: &filtererpb.ValidateResponse{
+				Diagnostics: []*filtererpb.Diagnostic{{
+					Severity: filtererpb.Severity_SEVERITY_ERROR,
+					Code:     "UNSUPPORTED",
+				}},
+			},
+		},
+		{
+			name:       "empty expression",
+			fieldSetID: "users",
+			want:       &filtererpb.ValidateResponse{Valid: true, Expression: &filtererpb.Expression{}},
+		},
+		{
+			name:       "unknown field set",
+			fieldSetID: "invoices",
+			input:      "age > 18",
+			wantCode:   connect.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := client.Validate(context.Background(), connect.NewRequest(&filtererpb.ValidateRequest{
+				FieldSetId: tt.fieldSetID,
+				Expr:       tt.input,
+			}))
+			var connectErr *connect.Error
+			switch {
+			case tt.wantCode == 0 && err != nil:
+				t.Errorf("Validate() error: %v", err)
+			case tt.wantCode != 0 && (!errors.As(err, &connectErr) || connectErr.Code() != tt.wantCode):
+				t.Errorf("Validate() error: %v, want code %v", err, tt.wantCode)
+			case tt.want != nil:
+				// messages of SQL generation errors aren't part of the contract
+				for _, diagnostic := range got.Msg.Diagnostics {
+					if diagnostic.Code == "UNSUPPORTED" {
+						diagnostic.Message = ""
+					}
+				}
+				if !proto.Equal(got.Msg, tt.want) {
+					t.Errorf("Validate() got: %v, want %v", got.Msg, tt.want)
+				}
+			}
+		})
+	}
+}
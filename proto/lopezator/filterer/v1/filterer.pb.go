@@ -0,0 +1,3219 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: lopezator/filterer/v1/filterer.proto
+
+package filtererv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The syntaxes a filter expression can be written in.
+type Syntax int32
+
+const (
+	Syntax_SYNTAX_UNSPECIFIED Syntax = 0
+	Syntax_SYNTAX_CEL         Syntax = 1
+	Syntax_SYNTAX_AIP160      Syntax = 2
+	Syntax_SYNTAX_MONGODB     Syntax = 3
+	Syntax_SYNTAX_SCIM        Syntax = 4
+)
+
+// Enum value maps for Syntax.
+var (
+	Syntax_name = map[int32]string{
+		0: "SYNTAX_UNSPECIFIED",
+		1: "SYNTAX_CEL",
+		2: "SYNTAX_AIP160",
+		3: "SYNTAX_MONGODB",
+		4: "SYNTAX_SCIM",
+	}
+	Syntax_value = map[string]int32{
+		"SYNTAX_UNSPECIFIED": 0,
+		"SYNTAX_CEL":         1,
+		"SYNTAX_AIP160":      2,
+		"SYNTAX_MONGODB":     3,
+		"SYNTAX_SCIM":        4,
+	}
+)
+
+func (x Syntax) Enum() *Syntax {
+	p := new(Syntax)
+	*p = x
+	return p
+}
+
+func (x Syntax) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Syntax) Descriptor() protoreflect.EnumDescriptor {
+	return file_lopezator_filterer_v1_filterer_proto_enumTypes[0].Descriptor()
+}
+
+func (Syntax) Type() protoreflect.EnumType {
+	return &file_lopezator_filterer_v1_filterer_proto_enumTypes[0]
+}
+
+func (x Syntax) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Syntax.Descriptor instead.
+func (Syntax) EnumDescriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{0}
+}
+
+// The SQL dialects a clause can be rendered for.
+type Dialect int32
+
+const (
+	Dialect_DIALECT_UNSPECIFIED Dialect = 0
+	Dialect_DIALECT_POSTGRESQL  Dialect = 1
+	Dialect_DIALECT_MYSQL       Dialect = 2
+	Dialect_DIALECT_SQLITE      Dialect = 3
+	Dialect_DIALECT_SQLSERVER   Dialect = 4
+	Dialect_DIALECT_COCKROACHDB Dialect = 5
+)
+
+// Enum value maps for Dialect.
+var (
+	Dialect_name = map[int32]string{
+		0: "DIALECT_UNSPECIFIED",
+		1: "DIALECT_POSTGRESQL",
+		2: "DIALECT_MYSQL",
+		3: "DIALECT_SQLITE",
+		4: "DIALECT_SQLSERVER",
+		5: "DIALECT_COCKROACHDB",
+	}
+	Dialect_value = map[string]int32{
+		"DIALECT_UNSPECIFIED": 0,
+		"DIALECT_POSTGRESQL":  1,
+		"DIALECT_MYSQL":       2,
+		"DIALECT_SQLITE":      3,
+		"DIALECT_SQLSERVER":   4,
+		"DIALECT_COCKROACHDB": 5,
+	}
+)
+
+func (x Dialect) Enum() *Dialect {
+	p := new(Dialect)
+	*p = x
+	return p
+}
+
+func (x Dialect) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Dialect) Descriptor() protoreflect.EnumDescriptor {
+	return file_lopezator_filterer_v1_filterer_proto_enumTypes[1].Descriptor()
+}
+
+func (Dialect) Type() protoreflect.EnumType {
+	return &file_lopezator_filterer_v1_filterer_proto_enumTypes[1]
+}
+
+func (x Dialect) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Dialect.Descriptor instead.
+func (Dialect) EnumDescriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{1}
+}
+
+// The severities of a diagnostic.
+type Severity int32
+
+const (
+	Severity_SEVERITY_UNSPECIFIED Severity = 0
+	Severity_SEVERITY_ERROR       Severity = 1
+	Severity_SEVERITY_WARNING     Severity = 2
+)
+
+// Enum value maps for Severity.
+var (
+	Severity_name = map[int32]string{
+		0: "SEVERITY_UNSPECIFIED",
+		1: "SEVERITY_ERROR",
+		2: "SEVERITY_WARNING",
+	}
+	Severity_value = map[string]int32{
+		"SEVERITY_UNSPECIFIED": 0,
+		"SEVERITY_ERROR":       1,
+		"SEVERITY_WARNING":     2,
+	}
+)
+
+func (x Severity) Enum() *Severity {
+	p := new(Severity)
+	*p = x
+	return p
+}
+
+func (x Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_lopezator_filterer_v1_filterer_proto_enumTypes[2].Descriptor()
+}
+
+func (Severity) Type() protoreflect.EnumType {
+	return &file_lopezator_filterer_v1_filterer_proto_enumTypes[2]
+}
+
+func (x Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Severity.Descriptor instead.
+func (Severity) EnumDescriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{2}
+}
+
+// The types of a field.
+type FieldType int32
+
+const (
+	FieldType_FIELD_TYPE_UNSPECIFIED  FieldType = 0
+	FieldType_FIELD_TYPE_BOOL         FieldType = 1
+	FieldType_FIELD_TYPE_INTEGER      FieldType = 2
+	FieldType_FIELD_TYPE_DOUBLE       FieldType = 3
+	FieldType_FIELD_TYPE_STRING       FieldType = 4
+	FieldType_FIELD_TYPE_BYTES        FieldType = 5
+	FieldType_FIELD_TYPE_TIMESTAMP    FieldType = 6
+	FieldType_FIELD_TYPE_STRING_ARRAY FieldType = 7
+)
+
+// Enum value maps for FieldType.
+var (
+	FieldType_name = map[int32]string{
+		0: "FIELD_TYPE_UNSPECIFIED",
+		1: "FIELD_TYPE_BOOL",
+		2: "FIELD_TYPE_INTEGER",
+		3: "FIELD_TYPE_DOUBLE",
+		4: "FIELD_TYPE_STRING",
+		5: "FIELD_TYPE_BYTES",
+		6: "FIELD_TYPE_TIMESTAMP",
+		7: "FIELD_TYPE_STRING_ARRAY",
+	}
+	FieldType_value = map[string]int32{
+		"FIELD_TYPE_UNSPECIFIED":  0,
+		"FIELD_TYPE_BOOL":         1,
+		"FIELD_TYPE_INTEGER":      2,
+		"FIELD_TYPE_DOUBLE":       3,
+		"FIELD_TYPE_STRING":       4,
+		"FIELD_TYPE_BYTES":        5,
+		"FIELD_TYPE_TIMESTAMP":    6,
+		"FIELD_TYPE_STRING_ARRAY": 7,
+	}
+)
+
+func (x FieldType) Enum() *FieldType {
+	p := new(FieldType)
+	*p = x
+	return p
+}
+
+func (x FieldType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FieldType) Descriptor() protoreflect.EnumDescriptor {
+	return file_lopezator_filterer_v1_filterer_proto_enumTypes[3].Descriptor()
+}
+
+func (FieldType) Type() protoreflect.EnumType {
+	return &file_lopezator_filterer_v1_filterer_proto_enumTypes[3]
+}
+
+func (x FieldType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FieldType.Descriptor instead.
+func (FieldType) EnumDescriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{3}
+}
+
+// The kinds of a suggestion.
+type SuggestionKind int32
+
+const (
+	SuggestionKind_SUGGESTION_KIND_UNSPECIFIED SuggestionKind = 0
+	SuggestionKind_SUGGESTION_KIND_FIELD       SuggestionKind = 1
+	SuggestionKind_SUGGESTION_KIND_OPERATOR    SuggestionKind = 2
+	SuggestionKind_SUGGESTION_KIND_METHOD      SuggestionKind = 3
+	SuggestionKind_SUGGESTION_KIND_FUNCTION    SuggestionKind = 4
+	SuggestionKind_SUGGESTION_KIND_LITERAL     SuggestionKind = 5
+)
+
+// Enum value maps for SuggestionKind.
+var (
+	SuggestionKind_name = map[int32]string{
+		0: "SUGGESTION_KIND_UNSPECIFIED",
+		1: "SUGGESTION_KIND_FIELD",
+		2: "SUGGESTION_KIND_OPERATOR",
+		3: "SUGGESTION_KIND_METHOD",
+		4: "SUGGESTION_KIND_FUNCTION",
+		5: "SUGGESTION_KIND_LITERAL",
+	}
+	SuggestionKind_value = map[string]int32{
+		"SUGGESTION_KIND_UNSPECIFIED": 0,
+		"SUGGESTION_KIND_FIELD":       1,
+		"SUGGESTION_KIND_OPERATOR":    2,
+		"SUGGESTION_KIND_METHOD":      3,
+		"SUGGESTION_KIND_FUNCTION":    4,
+		"SUGGESTION_KIND_LITERAL":     5,
+	}
+)
+
+func (x SuggestionKind) Enum() *SuggestionKind {
+	p := new(SuggestionKind)
+	*p = x
+	return p
+}
+
+func (x SuggestionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SuggestionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_lopezator_filterer_v1_filterer_proto_enumTypes[4].Descriptor()
+}
+
+func (SuggestionKind) Type() protoreflect.EnumType {
+	return &file_lopezator_filterer_v1_filterer_proto_enumTypes[4]
+}
+
+func (x SuggestionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SuggestionKind.Descriptor instead.
+func (SuggestionKind) EnumDescriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{4}
+}
+
+// The kinds of a node.
+type NodeKind int32
+
+const (
+	NodeKind_NODE_KIND_UNSPECIFIED NodeKind = 0
+	NodeKind_NODE_KIND_NOT         NodeKind = 1
+	NodeKind_NODE_KIND_AND         NodeKind = 2
+	NodeKind_NODE_KIND_OR          NodeKind = 3
+	NodeKind_NODE_KIND_OP          NodeKind = 4
+	NodeKind_NODE_KIND_PRESENT     NodeKind = 5
+	NodeKind_NODE_KIND_SIZE        NodeKind = 6
+	NodeKind_NODE_KIND_FIELD       NodeKind = 7
+)
+
+// Enum value maps for NodeKind.
+var (
+	NodeKind_name = map[int32]string{
+		0: "NODE_KIND_UNSPECIFIED",
+		1: "NODE_KIND_NOT",
+		2: "NODE_KIND_AND",
+		3: "NODE_KIND_OR",
+		4: "NODE_KIND_OP",
+		5: "NODE_KIND_PRESENT",
+		6: "NODE_KIND_SIZE",
+		7: "NODE_KIND_FIELD",
+	}
+	NodeKind_value = map[string]int32{
+		"NODE_KIND_UNSPECIFIED": 0,
+		"NODE_KIND_NOT":         1,
+		"NODE_KIND_AND":         2,
+		"NODE_KIND_OR":          3,
+		"NODE_KIND_OP":          4,
+		"NODE_KIND_PRESENT":     5,
+		"NODE_KIND_SIZE":        6,
+		"NODE_KIND_FIELD":       7,
+	}
+)
+
+func (x NodeKind) Enum() *NodeKind {
+	p := new(NodeKind)
+	*p = x
+	return p
+}
+
+func (x NodeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NodeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_lopezator_filterer_v1_filterer_proto_enumTypes[5].Descriptor()
+}
+
+func (NodeKind) Type() protoreflect.EnumType {
+	return &file_lopezator_filterer_v1_filterer_proto_enumTypes[5]
+}
+
+func (x NodeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NodeKind.Descriptor instead.
+func (NodeKind) EnumDescriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{5}
+}
+
+// The request message containing the field set and the filter string.
+type FilterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The filter expression.
+	Expr string `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	// The id of the field set the filter expression is checked against.
+	FieldSetId string `protobuf:"bytes,2,opt,name=field_set_id,json=fieldSetId,proto3" json:"field_set_id,omitempty"`
+	// The SQL dialect the clause is rendered for, CockroachDB if unspecified.
+	Dialect Dialect `protobuf:"varint,3,opt,name=dialect,proto3,enum=lopezator.filterer.v1.Dialect" json:"dialect,omitempty"`
+	// A previously parsed filter expression, used instead of expr if set.
+	Expression *Expression `protobuf:"bytes,4,opt,name=expression,proto3" json:"expression,omitempty"`
+	// The syntax of the filter expression, CEL if unspecified.
+	Syntax Syntax `protobuf:"varint,5,opt,name=syntax,proto3,enum=lopezator.filterer.v1.Syntax" json:"syntax,omitempty"`
+}
+
+func (x *FilterRequest) Reset() {
+	*x = FilterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterRequest) ProtoMessage() {}
+
+func (x *FilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterRequest.ProtoReflect.Descriptor instead.
+func (*FilterRequest) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FilterRequest) GetExpr() string {
+	if x != nil {
+		return x.Expr
+	}
+	return ""
+}
+
+func (x *FilterRequest) GetFieldSetId() string {
+	if x != nil {
+		return x.FieldSetId
+	}
+	return ""
+}
+
+func (x *FilterRequest) GetDialect() Dialect {
+	if x != nil {
+		return x.Dialect
+	}
+	return Dialect_DIALECT_UNSPECIFIED
+}
+
+func (x *FilterRequest) GetExpression() *Expression {
+	if x != nil {
+		return x.Expression
+	}
+	return nil
+}
+
+func (x *FilterRequest) GetSyntax() Syntax {
+	if x != nil {
+		return x.Syntax
+	}
+	return Syntax_SYNTAX_UNSPECIFIED
+}
+
+// The response message containing the sql to issue the filtering, based on the filter request.
+type FilterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The SQL clause to be used as the WHERE condition, without the WHERE keyword.
+	// Empty when the expression matches every row.
+	Where string `protobuf:"bytes,1,opt,name=where,proto3" json:"where,omitempty"`
+	// The arguments bound to the placeholders of the SQL clause, in order.
+	Args []*Argument `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+}
+
+func (x *FilterResponse) Reset() {
+	*x = FilterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterResponse) ProtoMessage() {}
+
+func (x *FilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterResponse.ProtoReflect.Descriptor instead.
+func (*FilterResponse) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FilterResponse) GetWhere() string {
+	if x != nil {
+		return x.Where
+	}
+	return ""
+}
+
+func (x *FilterResponse) GetArgs() []*Argument {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+// A typed argument bound to a placeholder of a SQL clause.
+type Argument struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The value of the argument.
+	//
+	// Types that are assignable to Value:
+	//	*Argument_StringValue
+	//	*Argument_Int64Value
+	//	*Argument_DoubleValue
+	//	*Argument_BoolValue
+	//	*Argument_BytesValue
+	//	*Argument_TimestampValue
+	//	*Argument_StringListValue
+	//	*Argument_NullValue
+	//	*Argument_Uint64Value
+	Value isArgument_Value `protobuf_oneof:"value"`
+}
+
+func (x *Argument) Reset() {
+	*x = Argument{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Argument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Argument) ProtoMessage() {}
+
+func (x *Argument) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Argument.ProtoReflect.Descriptor instead.
+func (*Argument) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *Argument) GetValue() isArgument_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *Argument) GetStringValue() string {
+	if x, ok := x.GetValue().(*Argument_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *Argument) GetInt64Value() int64 {
+	if x, ok := x.GetValue().(*Argument_Int64Value); ok {
+		return x.Int64Value
+	}
+	return 0
+}
+
+func (x *Argument) GetDoubleValue() float64 {
+	if x, ok := x.GetValue().(*Argument_DoubleValue); ok {
+		return x.DoubleValue
+	}
+	return 0
+}
+
+func (x *Argument) GetBoolValue() bool {
+	if x, ok := x.GetValue().(*Argument_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
+
+func (x *Argument) GetBytesValue() []byte {
+	if x, ok := x.GetValue().(*Argument_BytesValue); ok {
+		return x.BytesValue
+	}
+	return nil
+}
+
+func (x *Argument) GetTimestampValue() *timestamppb.Timestamp {
+	if x, ok := x.GetValue().(*Argument_TimestampValue); ok {
+		return x.TimestampValue
+	}
+	return nil
+}
+
+func (x *Argument) GetStringListValue() *StringList {
+	if x, ok := x.GetValue().(*Argument_StringListValue); ok {
+		return x.StringListValue
+	}
+	return nil
+}
+
+func (x *Argument) GetNullValue() structpb.NullValue {
+	if x, ok := x.GetValue().(*Argument_NullValue); ok {
+		return x.NullValue
+	}
+	return structpb.NullValue(0)
+}
+
+func (x *Argument) GetUint64Value() uint64 {
+	if x, ok := x.GetValue().(*Argument_Uint64Value); ok {
+		return x.Uint64Value
+	}
+	return 0
+}
+
+type isArgument_Value interface {
+	isArgument_Value()
+}
+
+type Argument_StringValue struct {
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type Argument_Int64Value struct {
+	Int64Value int64 `protobuf:"varint,2,opt,name=int64_value,json=int64Value,proto3,oneof"`
+}
+
+type Argument_DoubleValue struct {
+	DoubleValue float64 `protobuf:"fixed64,3,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+
+type Argument_BoolValue struct {
+	BoolValue bool `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type Argument_BytesValue struct {
+	BytesValue []byte `protobuf:"bytes,5,opt,name=bytes_value,json=bytesValue,proto3,oneof"`
+}
+
+type Argument_TimestampValue struct {
+	TimestampValue *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp_value,json=timestampValue,proto3,oneof"`
+}
+
+type Argument_StringListValue struct {
+	StringListValue *StringList `protobuf:"bytes,7,opt,name=string_list_value,json=stringListValue,proto3,oneof"`
+}
+
+type Argument_NullValue struct {
+	NullValue structpb.NullValue `protobuf:"varint,8,opt,name=null_value,json=nullValue,proto3,enum=google.protobuf.NullValue,oneof"`
+}
+
+type Argument_Uint64Value struct {
+	// Unsigned integers only appear in expression nodes, SQL arguments hold them as int64.
+	Uint64Value uint64 `protobuf:"varint,9,opt,name=uint64_value,json=uint64Value,proto3,oneof"`
+}
+
+func (*Argument_StringValue) isArgument_Value() {}
+
+func (*Argument_Int64Value) isArgument_Value() {}
+
+func (*Argument_DoubleValue) isArgument_Value() {}
+
+func (*Argument_BoolValue) isArgument_Value() {}
+
+func (*Argument_BytesValue) isArgument_Value() {}
+
+func (*Argument_TimestampValue) isArgument_Value() {}
+
+func (*Argument_StringListValue) isArgument_Value() {}
+
+func (*Argument_NullValue) isArgument_Value() {}
+
+func (*Argument_Uint64Value) isArgument_Value() {}
+
+// A list of strings.
+type StringList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *StringList) Reset() {
+	*x = StringList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringList) ProtoMessage() {}
+
+func (x *StringList) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringList.ProtoReflect.Descriptor instead.
+func (*StringList) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StringList) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// The request message containing the filter string to validate.
+type ValidateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The filter expression.
+	Expr string `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	// The id of the field set the filter expression is checked against.
+	FieldSetId string `protobuf:"bytes,2,opt,name=field_set_id,json=fieldSetId,proto3" json:"field_set_id,omitempty"`
+}
+
+func (x *ValidateRequest) Reset() {
+	*x = ValidateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRequest) ProtoMessage() {}
+
+func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ValidateRequest) GetExpr() string {
+	if x != nil {
+		return x.Expr
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetFieldSetId() string {
+	if x != nil {
+		return x.FieldSetId
+	}
+	return ""
+}
+
+// The response message containing the diagnostics of the filter expression.
+type ValidateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the filter expression is valid, that is, it has no error diagnostics.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// The errors and warnings found in the filter expression, in order of appearance.
+	Diagnostics []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// The fields referenced by a valid filter expression, in order of appearance.
+	Fields []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	// The operators used by a valid filter expression, in order of appearance.
+	Operators []string `protobuf:"bytes,4,rep,name=operators,proto3" json:"operators,omitempty"`
+	// The parsed filter expression, if valid, to be stored and filtered by later on.
+	Expression *Expression `protobuf:"bytes,5,opt,name=expression,proto3" json:"expression,omitempty"`
+	// The canonical text of a valid filter expression, with normalized spacing, parentheses and literals.
+	CanonicalExpr string `protobuf:"bytes,6,opt,name=canonical_expr,json=canonicalExpr,proto3" json:"canonical_expr,omitempty"`
+}
+
+func (x *ValidateResponse) Reset() {
+	*x = ValidateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateResponse) ProtoMessage() {}
+
+func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ValidateResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateResponse) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetOperators() []string {
+	if x != nil {
+		return x.Operators
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetExpression() *Expression {
+	if x != nil {
+		return x.Expression
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetCanonicalExpr() string {
+	if x != nil {
+		return x.CanonicalExpr
+	}
+	return ""
+}
+
+// A problem found in a filter expression.
+type Diagnostic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The severity of the problem.
+	Severity Severity `protobuf:"varint,1,opt,name=severity,proto3,enum=lopezator.filterer.v1.Severity" json:"severity,omitempty"`
+	// The kind of problem, such as UNKNOWN_FIELD or DUPLICATE_VALUE.
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// The human readable description of the problem.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// The part of the filter expression at fault, unset if unknown.
+	Span *Span `protobuf:"bytes,4,opt,name=span,proto3" json:"span,omitempty"`
+	// The field at fault, if any.
+	Field string `protobuf:"bytes,5,opt,name=field,proto3" json:"field,omitempty"`
+	// The operator at fault, if any.
+	Operator string `protobuf:"bytes,6,opt,name=operator,proto3" json:"operator,omitempty"`
+	// The known fields close to an unknown one.
+	Suggestions []string `protobuf:"bytes,7,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+}
+
+func (x *Diagnostic) Reset() {
+	*x = Diagnostic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Diagnostic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Diagnostic) ProtoMessage() {}
+
+func (x *Diagnostic) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Diagnostic.ProtoReflect.Descriptor instead.
+func (*Diagnostic) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Diagnostic) GetSeverity() Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Severity_SEVERITY_UNSPECIFIED
+}
+
+func (x *Diagnostic) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Diagnostic) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Diagnostic) GetSpan() *Span {
+	if x != nil {
+		return x.Span
+	}
+	return nil
+}
+
+func (x *Diagnostic) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Diagnostic) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *Diagnostic) GetSuggestions() []string {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// A part of a filter expression.
+type Span struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The byte offset of the start of the span.
+	Start int32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// The byte offset right after the end of the span.
+	End int32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	// The 1-based line of the start of the span.
+	Line int32 `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+	// The 1-based column, in characters, of the start of the span.
+	Column int32 `protobuf:"varint,4,opt,name=column,proto3" json:"column,omitempty"`
+}
+
+func (x *Span) Reset() {
+	*x = Span{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Span) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Span) ProtoMessage() {}
+
+func (x *Span) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Span.ProtoReflect.Descriptor instead.
+func (*Span) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Span) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Span) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *Span) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *Span) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+// The request message to list the field sets.
+type ListFieldSetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListFieldSetsRequest) Reset() {
+	*x = ListFieldSetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFieldSetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFieldSetsRequest) ProtoMessage() {}
+
+func (x *ListFieldSetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFieldSetsRequest.ProtoReflect.Descriptor instead.
+func (*ListFieldSetsRequest) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{8}
+}
+
+// The response message containing the field sets, in order of definition.
+type ListFieldSetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FieldSets []*FieldSet `protobuf:"bytes,1,rep,name=field_sets,json=fieldSets,proto3" json:"field_sets,omitempty"`
+}
+
+func (x *ListFieldSetsResponse) Reset() {
+	*x = ListFieldSetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFieldSetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFieldSetsResponse) ProtoMessage() {}
+
+func (x *ListFieldSetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFieldSetsResponse.ProtoReflect.Descriptor instead.
+func (*ListFieldSetsResponse) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListFieldSetsResponse) GetFieldSets() []*FieldSet {
+	if x != nil {
+		return x.FieldSets
+	}
+	return nil
+}
+
+// The request message containing the id of the field set to describe.
+type DescribeFieldSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the field set.
+	FieldSetId string `protobuf:"bytes,1,opt,name=field_set_id,json=fieldSetId,proto3" json:"field_set_id,omitempty"`
+}
+
+func (x *DescribeFieldSetRequest) Reset() {
+	*x = DescribeFieldSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeFieldSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeFieldSetRequest) ProtoMessage() {}
+
+func (x *DescribeFieldSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeFieldSetRequest.ProtoReflect.Descriptor instead.
+func (*DescribeFieldSetRequest) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DescribeFieldSetRequest) GetFieldSetId() string {
+	if x != nil {
+		return x.FieldSetId
+	}
+	return ""
+}
+
+// The response message containing the described field set.
+type DescribeFieldSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FieldSet *FieldSet `protobuf:"bytes,1,opt,name=field_set,json=fieldSet,proto3" json:"field_set,omitempty"`
+}
+
+func (x *DescribeFieldSetResponse) Reset() {
+	*x = DescribeFieldSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeFieldSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeFieldSetResponse) ProtoMessage() {}
+
+func (x *DescribeFieldSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeFieldSetResponse.ProtoReflect.Descriptor instead.
+func (*DescribeFieldSetResponse) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DescribeFieldSetResponse) GetFieldSet() *FieldSet {
+	if x != nil {
+		return x.FieldSet
+	}
+	return nil
+}
+
+// A set of filterable fields.
+type FieldSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the field set.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The fields of the field set, sorted by name.
+	Fields []*Field `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *FieldSet) Reset() {
+	*x = FieldSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldSet) ProtoMessage() {}
+
+func (x *FieldSet) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldSet.ProtoReflect.Descriptor instead.
+func (*FieldSet) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FieldSet) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FieldSet) GetFields() []*Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// A filterable field.
+type Field struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the field, as referenced in filter expressions.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The type of the field.
+	Type FieldType `protobuf:"varint,2,opt,name=type,proto3,enum=lopezator.filterer.v1.FieldType" json:"type,omitempty"`
+	// The operators the field can be used with.
+	Operators []string `protobuf:"bytes,3,rep,name=operators,proto3" json:"operators,omitempty"`
+}
+
+func (x *Field) Reset() {
+	*x = Field{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Field) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Field) ProtoMessage() {}
+
+func (x *Field) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Field.ProtoReflect.Descriptor instead.
+func (*Field) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Field) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Field) GetType() FieldType {
+	if x != nil {
+		return x.Type
+	}
+	return FieldType_FIELD_TYPE_UNSPECIFIED
+}
+
+func (x *Field) GetOperators() []string {
+	if x != nil {
+		return x.Operators
+	}
+	return nil
+}
+
+// The request message containing the partial filter string to complete.
+type CompleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The partial filter expression.
+	Expr string `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	// The id of the field set the filter expression is checked against.
+	FieldSetId string `protobuf:"bytes,2,opt,name=field_set_id,json=fieldSetId,proto3" json:"field_set_id,omitempty"`
+	// The byte offset of the cursor within the filter expression, its end if out of range.
+	Cursor int32 `protobuf:"varint,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *CompleteRequest) Reset() {
+	*x = CompleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteRequest) ProtoMessage() {}
+
+func (x *CompleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteRequest.ProtoReflect.Descriptor instead.
+func (*CompleteRequest) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CompleteRequest) GetExpr() string {
+	if x != nil {
+		return x.Expr
+	}
+	return ""
+}
+
+func (x *CompleteRequest) GetFieldSetId() string {
+	if x != nil {
+		return x.FieldSetId
+	}
+	return ""
+}
+
+func (x *CompleteRequest) GetCursor() int32 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+// The response message containing the completion suggestions, best first.
+type CompleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Suggestions []*Suggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+}
+
+func (x *CompleteResponse) Reset() {
+	*x = CompleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteResponse) ProtoMessage() {}
+
+func (x *CompleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteResponse.ProtoReflect.Descriptor instead.
+func (*CompleteResponse) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CompleteResponse) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// A completion suggestion.
+type Suggestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The text to insert.
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// The kind of suggestion.
+	Kind SuggestionKind `protobuf:"varint,2,opt,name=kind,proto3,enum=lopezator.filterer.v1.SuggestionKind" json:"kind,omitempty"`
+	// The description of the suggestion, such as the type of a field.
+	Detail string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	// The byte offset of the start of the text replaced by the suggestion.
+	ReplaceStart int32 `protobuf:"varint,4,opt,name=replace_start,json=replaceStart,proto3" json:"replace_start,omitempty"`
+	// The byte offset right after the end of the text replaced by the suggestion.
+	ReplaceEnd int32 `protobuf:"varint,5,opt,name=replace_end,json=replaceEnd,proto3" json:"replace_end,omitempty"`
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Suggestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Suggestion) GetKind() SuggestionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SuggestionKind_SUGGESTION_KIND_UNSPECIFIED
+}
+
+func (x *Suggestion) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *Suggestion) GetReplaceStart() int32 {
+	if x != nil {
+		return x.ReplaceStart
+	}
+	return 0
+}
+
+func (x *Suggestion) GetReplaceEnd() int32 {
+	if x != nil {
+		return x.ReplaceEnd
+	}
+	return 0
+}
+
+// The request message containing the filter string to explain.
+type ExplainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The filter expression.
+	Expr string `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	// The id of the field set the filter expression is checked against.
+	FieldSetId string `protobuf:"bytes,2,opt,name=field_set_id,json=fieldSetId,proto3" json:"field_set_id,omitempty"`
+	// The SQL dialect the clause is rendered for, CockroachDB if unspecified.
+	Dialect Dialect `protobuf:"varint,3,opt,name=dialect,proto3,enum=lopezator.filterer.v1.Dialect" json:"dialect,omitempty"`
+}
+
+func (x *ExplainRequest) Reset() {
+	*x = ExplainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainRequest) ProtoMessage() {}
+
+func (x *ExplainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainRequest.ProtoReflect.Descriptor instead.
+func (*ExplainRequest) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExplainRequest) GetExpr() string {
+	if x != nil {
+		return x.Expr
+	}
+	return ""
+}
+
+func (x *ExplainRequest) GetFieldSetId() string {
+	if x != nil {
+		return x.FieldSetId
+	}
+	return ""
+}
+
+func (x *ExplainRequest) GetDialect() Dialect {
+	if x != nil {
+		return x.Dialect
+	}
+	return Dialect_DIALECT_UNSPECIFIED
+}
+
+// The response message containing the tree of the filter expression.
+type ExplainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The root node of the filter expression, unset if empty.
+	Root *ExplainNode `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// The SQL clause, as returned by Filter.
+	Where string `protobuf:"bytes,2,opt,name=where,proto3" json:"where,omitempty"`
+	// The arguments bound to the placeholders of the SQL clause, in order.
+	Args []*Argument `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+}
+
+func (x *ExplainResponse) Reset() {
+	*x = ExplainResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainResponse) ProtoMessage() {}
+
+func (x *ExplainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainResponse.ProtoReflect.Descriptor instead.
+func (*ExplainResponse) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExplainResponse) GetRoot() *ExplainNode {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *ExplainResponse) GetWhere() string {
+	if x != nil {
+		return x.Where
+	}
+	return ""
+}
+
+func (x *ExplainResponse) GetArgs() []*Argument {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+// A node of a filter expression and the SQL it's translated to.
+type ExplainNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The kind of node.
+	Kind NodeKind `protobuf:"varint,1,opt,name=kind,proto3,enum=lopezator.filterer.v1.NodeKind" json:"kind,omitempty"`
+	// The operator of the node, if any.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// The name of the field of field, present and size nodes.
+	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// The type of the field of field, present and size nodes.
+	FieldType FieldType `protobuf:"varint,4,opt,name=field_type,json=fieldType,proto3,enum=lopezator.filterer.v1.FieldType" json:"field_type,omitempty"`
+	// The arguments of operation nodes.
+	Args []*Argument `protobuf:"bytes,5,rep,name=args,proto3" json:"args,omitempty"`
+	// The SQL fragment of the node, with placeholders numbered as in the whole clause.
+	Sql string `protobuf:"bytes,6,opt,name=sql,proto3" json:"sql,omitempty"`
+	// The children nodes, in order.
+	Children []*ExplainNode `protobuf:"bytes,7,rep,name=children,proto3" json:"children,omitempty"`
+}
+
+func (x *ExplainNode) Reset() {
+	*x = ExplainNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainNode) ProtoMessage() {}
+
+func (x *ExplainNode) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainNode.ProtoReflect.Descriptor instead.
+func (*ExplainNode) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExplainNode) GetKind() NodeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return NodeKind_NODE_KIND_UNSPECIFIED
+}
+
+func (x *ExplainNode) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *ExplainNode) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ExplainNode) GetFieldType() FieldType {
+	if x != nil {
+		return x.FieldType
+	}
+	return FieldType_FIELD_TYPE_UNSPECIFIED
+}
+
+func (x *ExplainNode) GetArgs() []*Argument {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *ExplainNode) GetSql() string {
+	if x != nil {
+		return x.Sql
+	}
+	return ""
+}
+
+func (x *ExplainNode) GetChildren() []*ExplainNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+// The request message containing the filter string and the records to match.
+type EvaluateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The filter expression.
+	Expr string `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	// The id of the field set the filter expression is checked against.
+	FieldSetId string `protobuf:"bytes,2,opt,name=field_set_id,json=fieldSetId,proto3" json:"field_set_id,omitempty"`
+	// The records to match, with fields looked up by name and dotted names within nested objects.
+	Records []*structpb.Struct `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+	// Whether to return the matching records along with their indices.
+	IncludeRecords bool `protobuf:"varint,4,opt,name=include_records,json=includeRecords,proto3" json:"include_records,omitempty"`
+}
+
+func (x *EvaluateRequest) Reset() {
+	*x = EvaluateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateRequest) ProtoMessage() {}
+
+func (x *EvaluateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateRequest) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EvaluateRequest) GetExpr() string {
+	if x != nil {
+		return x.Expr
+	}
+	return ""
+}
+
+func (x *EvaluateRequest) GetFieldSetId() string {
+	if x != nil {
+		return x.FieldSetId
+	}
+	return ""
+}
+
+func (x *EvaluateRequest) GetRecords() []*structpb.Struct {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *EvaluateRequest) GetIncludeRecords() bool {
+	if x != nil {
+		return x.IncludeRecords
+	}
+	return false
+}
+
+// The response message containing the records matching the filter expression.
+type EvaluateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The indices of the matching records, in order.
+	Indices []int32 `protobuf:"varint,1,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	// The matching records, in order, if requested.
+	Records []*structpb.Struct `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *EvaluateResponse) Reset() {
+	*x = EvaluateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateResponse) ProtoMessage() {}
+
+func (x *EvaluateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateResponse) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *EvaluateResponse) GetIndices() []int32 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *EvaluateResponse) GetRecords() []*structpb.Struct {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// A parsed filter expression.
+type Expression struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The root node of the filter expression, unset if empty.
+	Root *Node `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (x *Expression) Reset() {
+	*x = Expression{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Expression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Expression) ProtoMessage() {}
+
+func (x *Expression) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Expression.ProtoReflect.Descriptor instead.
+func (*Expression) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Expression) GetRoot() *Node {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+// A node of a parsed filter expression.
+type Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The node, tagged by its kind.
+	//
+	// Types that are assignable to Node:
+	//	*Node_Not
+	//	*Node_And
+	//	*Node_Or
+	//	*Node_Op
+	//	*Node_Present
+	//	*Node_Size
+	//	*Node_Field
+	Node isNode_Node `protobuf_oneof:"node"`
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{23}
+}
+
+func (m *Node) GetNode() isNode_Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+func (x *Node) GetNot() *Node {
+	if x, ok := x.GetNode().(*Node_Not); ok {
+		return x.Not
+	}
+	return nil
+}
+
+func (x *Node) GetAnd() *BinaryNode {
+	if x, ok := x.GetNode().(*Node_And); ok {
+		return x.And
+	}
+	return nil
+}
+
+func (x *Node) GetOr() *BinaryNode {
+	if x, ok := x.GetNode().(*Node_Or); ok {
+		return x.Or
+	}
+	return nil
+}
+
+func (x *Node) GetOp() *OperationNode {
+	if x, ok := x.GetNode().(*Node_Op); ok {
+		return x.Op
+	}
+	return nil
+}
+
+func (x *Node) GetPresent() *FieldNode {
+	if x, ok := x.GetNode().(*Node_Present); ok {
+		return x.Present
+	}
+	return nil
+}
+
+func (x *Node) GetSize() *FieldNode {
+	if x, ok := x.GetNode().(*Node_Size); ok {
+		return x.Size
+	}
+	return nil
+}
+
+func (x *Node) GetField() *FieldNode {
+	if x, ok := x.GetNode().(*Node_Field); ok {
+		return x.Field
+	}
+	return nil
+}
+
+type isNode_Node interface {
+	isNode_Node()
+}
+
+type Node_Not struct {
+	// The negation of a node.
+	Not *Node `protobuf:"bytes,1,opt,name=not,proto3,oneof"`
+}
+
+type Node_And struct {
+	// The conjunction of two nodes.
+	And *BinaryNode `protobuf:"bytes,2,opt,name=and,proto3,oneof"`
+}
+
+type Node_Or struct {
+	// The disjunction of two nodes.
+	Or *BinaryNode `protobuf:"bytes,3,opt,name=or,proto3,oneof"`
+}
+
+type Node_Op struct {
+	// An operation on a node.
+	Op *OperationNode `protobuf:"bytes,4,opt,name=op,proto3,oneof"`
+}
+
+type Node_Present struct {
+	// The presence of a field.
+	Present *FieldNode `protobuf:"bytes,5,opt,name=present,proto3,oneof"`
+}
+
+type Node_Size struct {
+	// The size of a field.
+	Size *FieldNode `protobuf:"bytes,6,opt,name=size,proto3,oneof"`
+}
+
+type Node_Field struct {
+	// A field.
+	Field *FieldNode `protobuf:"bytes,7,opt,name=field,proto3,oneof"`
+}
+
+func (*Node_Not) isNode_Node() {}
+
+func (*Node_And) isNode_Node() {}
+
+func (*Node_Or) isNode_Node() {}
+
+func (*Node_Op) isNode_Node() {}
+
+func (*Node_Present) isNode_Node() {}
+
+func (*Node_Size) isNode_Node() {}
+
+func (*Node_Field) isNode_Node() {}
+
+// The operands of a binary node.
+type BinaryNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Left  *Node `protobuf:"bytes,1,opt,name=left,proto3" json:"left,omitempty"`
+	Right *Node `protobuf:"bytes,2,opt,name=right,proto3" json:"right,omitempty"`
+}
+
+func (x *BinaryNode) Reset() {
+	*x = BinaryNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryNode) ProtoMessage() {}
+
+func (x *BinaryNode) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryNode.ProtoReflect.Descriptor instead.
+func (*BinaryNode) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BinaryNode) GetLeft() *Node {
+	if x != nil {
+		return x.Left
+	}
+	return nil
+}
+
+func (x *BinaryNode) GetRight() *Node {
+	if x != nil {
+		return x.Right
+	}
+	return nil
+}
+
+// An operation node, such as a comparison.
+type OperationNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The operand of the operation.
+	Left *Node `protobuf:"bytes,1,opt,name=left,proto3" json:"left,omitempty"`
+	// The operator.
+	Op string `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	// The arguments of the operation, in order.
+	Args []*Argument `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+}
+
+func (x *OperationNode) Reset() {
+	*x = OperationNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationNode) ProtoMessage() {}
+
+func (x *OperationNode) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationNode.ProtoReflect.Descriptor instead.
+func (*OperationNode) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *OperationNode) GetLeft() *Node {
+	if x != nil {
+		return x.Left
+	}
+	return nil
+}
+
+func (x *OperationNode) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *OperationNode) GetArgs() []*Argument {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+// A field of a node. Fields are resolved against the field set, so their columns aren't held.
+type FieldNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the field.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The type of the field.
+	Type FieldType `protobuf:"varint,2,opt,name=type,proto3,enum=lopezator.filterer.v1.FieldType" json:"type,omitempty"`
+}
+
+func (x *FieldNode) Reset() {
+	*x = FieldNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldNode) ProtoMessage() {}
+
+func (x *FieldNode) ProtoReflect() protoreflect.Message {
+	mi := &file_lopezator_filterer_v1_filterer_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldNode.ProtoReflect.Descriptor instead.
+func (*FieldNode) Descriptor() ([]byte, []int) {
+	return file_lopezator_filterer_v1_filterer_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FieldNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FieldNode) GetType() FieldType {
+	if x != nil {
+		return x.Type
+	}
+	return FieldType_FIELD_TYPE_UNSPECIFIED
+}
+
+var File_lopezator_filterer_v1_filterer_proto protoreflect.FileDescriptor
+
+var file_lopezator_filterer_v1_filterer_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf9, 0x01, 0x0a,
+	0x0d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78,
+	0x70, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x07, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
+	0x61, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x07, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x41,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x79, 0x6e, 0x74, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x74, 0x61, 0x78,
+	0x52, 0x06, 0x73, 0x79, 0x6e, 0x74, 0x61, 0x78, 0x22, 0x5b, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x68,
+	0x65, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65,
+	0x12, 0x33, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0xbe, 0x03, 0x0a, 0x08, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0a,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x00, 0x52, 0x0b, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1f, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x21, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x45, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x6e,
+	0x75, 0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x09, 0x6e,
+	0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x75, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00,
+	0x52, 0x0b, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x24, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x0f,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65,
+	0x78, 0x70, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x53, 0x65, 0x74, 0x49, 0x64, 0x22, 0x8d, 0x02, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x12, 0x43, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61,
+	0x6c, 0x45, 0x78, 0x70, 0x72, 0x22, 0xfc, 0x01, 0x0a, 0x0a, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x2f, 0x0a, 0x04, 0x73, 0x70, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x04, 0x73, 0x70, 0x61, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5a, 0x0a, 0x04, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x57, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x74,
+	0x73, 0x22, 0x3b, 0x0a, 0x17, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x49, 0x64, 0x22, 0x58,
+	0x0a, 0x18, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x52, 0x08,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x22, 0x50, 0x0a, 0x08, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x53, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x6f, 0x0a, 0x05, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x5f, 0x0a, 0x0f, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78,
+	0x70, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x57, 0x0a, 0x10,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x43, 0x0a, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x0a, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x39, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x45, 0x6e,
+	0x64, 0x22, 0x80, 0x01, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x70, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x07, 0x64, 0x69,
+	0x61, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x6f,
+	0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x07, 0x64, 0x69, 0x61,
+	0x6c, 0x65, 0x63, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x67,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0xbc, 0x02, 0x0a, 0x0b,
+	0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6c, 0x6f, 0x70, 0x65,
+	0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x3f, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x3e, 0x0a, 0x08, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c,
+	0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0xa3, 0x01, 0x0a, 0x0f, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78,
+	0x70, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x22, 0x5f, 0x0a, 0x10, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x31,
+	0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x22, 0x3d, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x2f, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74,
+	0x22, 0x93, 0x03, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x03, 0x6e, 0x6f, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x03, 0x6e, 0x6f, 0x74, 0x12, 0x35, 0x0a, 0x03, 0x61, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6e,
+	0x64, 0x12, 0x33, 0x0a, 0x02, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65,
+	0x48, 0x00, 0x52, 0x02, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x3c,
+	0x0a, 0x07, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x6f, 0x64,
+	0x65, 0x48, 0x00, 0x52, 0x07, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x6f, 0x70,
+	0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x06,
+	0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x70, 0x0a, 0x0a, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x6c, 0x65, 0x66, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x0d, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x6c, 0x65,
+	0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x61,
+	0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x6f, 0x70, 0x65,
+	0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73,
+	0x22, 0x55, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x20, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x2a, 0x68, 0x0a, 0x06, 0x53, 0x79, 0x6e, 0x74, 0x61,
+	0x78, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x59, 0x4e, 0x54, 0x41, 0x58, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x59, 0x4e,
+	0x54, 0x41, 0x58, 0x5f, 0x43, 0x45, 0x4c, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x59, 0x4e,
+	0x54, 0x41, 0x58, 0x5f, 0x41, 0x49, 0x50, 0x31, 0x36, 0x30, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e,
+	0x53, 0x59, 0x4e, 0x54, 0x41, 0x58, 0x5f, 0x4d, 0x4f, 0x4e, 0x47, 0x4f, 0x44, 0x42, 0x10, 0x03,
+	0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x59, 0x4e, 0x54, 0x41, 0x58, 0x5f, 0x53, 0x43, 0x49, 0x4d, 0x10,
+	0x04, 0x2a, 0x91, 0x01, 0x0a, 0x07, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x17, 0x0a,
+	0x13, 0x44, 0x49, 0x41, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x49, 0x41, 0x4c, 0x45, 0x43,
+	0x54, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x47, 0x52, 0x45, 0x53, 0x51, 0x4c, 0x10, 0x01, 0x12, 0x11,
+	0x0a, 0x0d, 0x44, 0x49, 0x41, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x59, 0x53, 0x51, 0x4c, 0x10,
+	0x02, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x49, 0x41, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x51, 0x4c,
+	0x49, 0x54, 0x45, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x49, 0x41, 0x4c, 0x45, 0x43, 0x54,
+	0x5f, 0x53, 0x51, 0x4c, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13,
+	0x44, 0x49, 0x41, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x4f, 0x43, 0x4b, 0x52, 0x4f, 0x41, 0x43,
+	0x48, 0x44, 0x42, 0x10, 0x05, 0x2a, 0x4e, 0x0a, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53,
+	0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12,
+	0x14, 0x0a, 0x10, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x57, 0x41, 0x52, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x02, 0x2a, 0xcf, 0x01, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x13, 0x0a, 0x0f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4f,
+	0x4f, 0x4c, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11,
+	0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x4f, 0x55, 0x42, 0x4c,
+	0x45, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x05,
+	0x12, 0x18, 0x0a, 0x14, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54,
+	0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d, 0x50, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x5f,
+	0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x07, 0x2a, 0xc1, 0x01, 0x0a, 0x0e, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x55,
+	0x47, 0x47, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53,
+	0x55, 0x47, 0x47, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x46,
+	0x49, 0x45, 0x4c, 0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x55, 0x47, 0x47, 0x45, 0x53,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x4f, 0x52, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x55, 0x47, 0x47, 0x45, 0x53, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x10, 0x03,
+	0x12, 0x1c, 0x0a, 0x18, 0x53, 0x55, 0x47, 0x47, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x1b,
+	0x0a, 0x17, 0x53, 0x55, 0x47, 0x47, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x4c, 0x49, 0x54, 0x45, 0x52, 0x41, 0x4c, 0x10, 0x05, 0x2a, 0xaf, 0x01, 0x0a, 0x08,
+	0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x4e, 0x4f, 0x44, 0x45,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x4e, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x41, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x44,
+	0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x4e,
+	0x4f, 0x44, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4f, 0x50, 0x10, 0x04, 0x12, 0x15, 0x0a,
+	0x11, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45,
+	0x4e, 0x54, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x4e, 0x4f, 0x44, 0x45,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x10, 0x07, 0x32, 0xc8, 0x05,
+	0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x57, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x6c, 0x6f,
+	0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x08, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x0d, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x73, 0x12, 0x2b, 0x2e, 0x6c, 0x6f, 0x70,
+	0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x12, 0x2e, 0x2e, 0x6c, 0x6f,
+	0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6c, 0x6f,
+	0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d,
+	0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x6c, 0x6f, 0x70,
+	0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a,
+	0x07, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x12, 0x25, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x08, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0xe6, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x6f, 0x70,
+	0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2f,
+	0x76, 0x31, 0x3b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x4c, 0x46, 0x58, 0xaa, 0x02, 0x15, 0x4c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4c, 0x6f,
+	0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x5c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x4c, 0x6f, 0x70, 0x65, 0x7a, 0x61, 0x74, 0x6f, 0x72, 0x5c,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x4c, 0x6f, 0x70, 0x65, 0x7a, 0x61,
+	0x74, 0x6f, 0x72, 0x3a, 0x3a, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x72, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_lopezator_filterer_v1_filterer_proto_rawDescOnce sync.Once
+	file_lopezator_filterer_v1_filterer_proto_rawDescData = file_lopezator_filterer_v1_filterer_proto_rawDesc
+)
+
+func file_lopezator_filterer_v1_filterer_proto_rawDescGZIP() []byte {
+	file_lopezator_filterer_v1_filterer_proto_rawDescOnce.Do(func() {
+		file_lopezator_filterer_v1_filterer_proto_rawDescData = protoimpl.X.CompressGZIP(file_lopezator_filterer_v1_filterer_proto_rawDescData)
+	})
+	return file_lopezator_filterer_v1_filterer_proto_rawDescData
+}
+
+var file_lopezator_filterer_v1_filterer_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_lopezator_filterer_v1_filterer_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_lopezator_filterer_v1_filterer_proto_goTypes = []interface{}{
+	(Syntax)(0),                      // 0: lopezator.filterer.v1.Syntax
+	(Dialect)(0),                     // 1: lopezator.filterer.v1.Dialect
+	(Severity)(0),                    // 2: lopezator.filterer.v1.Severity
+	(FieldType)(0),                   // 3: lopezator.filterer.v1.FieldType
+	(SuggestionKind)(0),              // 4: lopezator.filterer.v1.SuggestionKind
+	(NodeKind)(0),                    // 5: lopezator.filterer.v1.NodeKind
+	(*FilterRequest)(nil),            // 6: lopezator.filterer.v1.FilterRequest
+	(*FilterResponse)(nil),           // 7: lopezator.filterer.v1.FilterResponse
+	(*Argument)(nil),                 // 8: lopezator.filterer.v1.Argument
+	(*StringList)(nil),               // 9: lopezator.filterer.v1.StringList
+	(*ValidateRequest)(nil),          // 10: lopezator.filterer.v1.ValidateRequest
+	(*ValidateResponse)(nil),         // 11: lopezator.filterer.v1.ValidateResponse
+	(*Diagnostic)(nil),               // 12: lopezator.filterer.v1.Diagnostic
+	(*Span)(nil),                     // 13: lopezator.filterer.v1.Span
+	(*ListFieldSetsRequest)(nil),     // 14: lopezator.filterer.v1.ListFieldSetsRequest
+	(*ListFieldSetsResponse)(nil),    // 15: lopezator.filterer.v1.ListFieldSetsResponse
+	(*DescribeFieldSetRequest)(nil),  // 16: lopezator.filterer.v1.DescribeFieldSetRequest
+	(*DescribeFieldSetResponse)(nil), // 17: lopezator.filterer.v1.DescribeFieldSetResponse
+	(*FieldSet)(nil),                 // 18: lopezator.filterer.v1.FieldSet
+	(*Field)(nil),                    // 19: lopezator.filterer.v1.Field
+	(*CompleteRequest)(nil),          // 20: lopezator.filterer.v1.CompleteRequest
+	(*CompleteResponse)(nil),         // 21: lopezator.filterer.v1.CompleteResponse
+	(*Suggestion)(nil),               // 22: lopezator.filterer.v1.Suggestion
+	(*ExplainRequest)(nil),           // 23: lopezator.filterer.v1.ExplainRequest
+	(*ExplainResponse)(nil),          // 24: lopezator.filterer.v1.ExplainResponse
+	(*ExplainNode)(nil),              // 25: lopezator.filterer.v1.ExplainNode
+	(*EvaluateRequest)(nil),          // 26: lopezator.filterer.v1.EvaluateRequest
+	(*EvaluateResponse)(nil),         // 27: lopezator.filterer.v1.EvaluateResponse
+	(*Expression)(nil),               // 28: lopezator.filterer.v1.Expression
+	(*Node)(nil),                     // 29: lopezator.filterer.v1.Node
+	(*BinaryNode)(nil),               // 30: lopezator.filterer.v1.BinaryNode
+	(*OperationNode)(nil),            // 31: lopezator.filterer.v1.OperationNode
+	(*FieldNode)(nil),                // 32: lopezator.filterer.v1.FieldNode
+	(*timestamppb.Timestamp)(nil),    // 33: google.protobuf.Timestamp
+	(structpb.NullValue)(0),          // 34: google.protobuf.NullValue
+	(*structpb.Struct)(nil),          // 35: google.protobuf.Struct
+}
+var file_lopezator_filterer_v1_filterer_proto_depIdxs = []int32{
+	1,  // 0: lopezator.filterer.v1.FilterRequest.dialect:type_name -> lopezator.filterer.v1.Dialect
+	28, // 1: lopezator.filterer.v1.FilterRequest.expression:type_name -> lopezator.filterer.v1.Expression
+	0,  // 2: lopezator.filterer.v1.FilterRequest.syntax:type_name -> lopezator.filterer.v1.Syntax
+	8,  // 3: lopezator.filterer.v1.FilterResponse.args:type_name -> lopezator.filterer.v1.Argument
+	33, // 4: lopezator.filterer.v1.Argument.timestamp_value:type_name -> google.protobuf.Timestamp
+	9,  // 5: lopezator.filterer.v1.Argument.string_list_value:type_name -> lopezator.filterer.v1.StringList
+	34, // 6: lopezator.filterer.v1.Argument.null_value:type_name -> google.protobuf.NullValue
+	12, // 7: lopezator.filterer.v1.ValidateResponse.diagnostics:type_name -> lopezator.filterer.v1.Diagnostic
+	28, // 8: lopezator.filterer.v1.ValidateResponse.expression:type_name -> lopezator.filterer.v1.Expression
+	2,  // 9: lopezator.filterer.v1.Diagnostic.severity:type_name -> lopezator.filterer.v1.Severity
+	13, // 10: lopezator.filterer.v1.Diagnostic.span:type_name -> lopezator.filterer.v1.Span
+	18, // 11: lopezator.filterer.v1.ListFieldSetsResponse.field_sets:type_name -> lopezator.filterer.v1.FieldSet
+	18, // 12: lopezator.filterer.v1.DescribeFieldSetResponse.field_set:type_name -> lopezator.filterer.v1.FieldSet
+	19, // 13: lopezator.filterer.v1.FieldSet.fields:type_name -> lopezator.filterer.v1.Field
+	3,  // 14: lopezator.filterer.v1.Field.type:type_name -> lopezator.filterer.v1.FieldType
+	22, // 15: lopezator.filterer.v1.CompleteResponse.suggestions:type_name -> lopezator.filterer.v1.Suggestion
+	4,  // 16: lopezator.filterer.v1.Suggestion.kind:type_name -> lopezator.filterer.v1.SuggestionKind
+	1,  // 17: lopezator.filterer.v1.ExplainRequest.dialect:type_name -> lopezator.filterer.v1.Dialect
+	25, // 18: lopezator.filterer.v1.ExplainResponse.root:type_name -> lopezator.filterer.v1.ExplainNode
+	8,  // 19: lopezator.filterer.v1.ExplainResponse.args:type_name -> lopezator.filterer.v1.Argument
+	5,  // 20: lopezator.filterer.v1.ExplainNode.kind:type_name -> lopezator.filterer.v1.NodeKind
+	3,  // 21: lopezator.filterer.v1.ExplainNode.field_type:type_name -> lopezator.filterer.v1.FieldType
+	8,  // 22: lopezator.filterer.v1.ExplainNode.args:type_name -> lopezator.filterer.v1.Argument
+	25, // 23: lopezator.filterer.v1.ExplainNode.children:type_name -> lopezator.filterer.v1.ExplainNode
+	35, // 24: lopezator.filterer.v1.EvaluateRequest.records:type_name -> google.protobuf.Struct
+	35, // 25: lopezator.filterer.v1.EvaluateResponse.records:type_name -> google.protobuf.Struct
+	29, // 26: lopezator.filterer.v1.Expression.root:type_name -> lopezator.filterer.v1.Node
+	29, // 27: lopezator.filterer.v1.Node.not:type_name -> lopezator.filterer.v1.Node
+	30, // 28: lopezator.filterer.v1.Node.and:type_name -> lopezator.filterer.v1.BinaryNode
+	30, // 29: lopezator.filterer.v1.Node.or:type_name -> lopezator.filterer.v1.BinaryNode
+	31, // 30: lopezator.filterer.v1.Node.op:type_name -> lopezator.filterer.v1.OperationNode
+	32, // 31: lopezator.filterer.v1.Node.present:type_name -> lopezator.filterer.v1.FieldNode
+	32, // 32: lopezator.filterer.v1.Node.size:type_name -> lopezator.filterer.v1.FieldNode
+	32, // 33: lopezator.filterer.v1.Node.field:type_name -> lopezator.filterer.v1.FieldNode
+	29, // 34: lopezator.filterer.v1.BinaryNode.left:type_name -> lopezator.filterer.v1.Node
+	29, // 35: lopezator.filterer.v1.BinaryNode.right:type_name -> lopezator.filterer.v1.Node
+	29, // 36: lopezator.filterer.v1.OperationNode.left:type_name -> lopezator.filterer.v1.Node
+	8,  // 37: lopezator.filterer.v1.OperationNode.args:type_name -> lopezator.filterer.v1.Argument
+	3,  // 38: lopezator.filterer.v1.FieldNode.type:type_name -> lopezator.filterer.v1.FieldType
+	6,  // 39: lopezator.filterer.v1.FiltererService.Filter:input_type -> lopezator.filterer.v1.FilterRequest
+	10, // 40: lopezator.filterer.v1.FiltererService.Validate:input_type -> lopezator.filterer.v1.ValidateRequest
+	14, // 41: lopezator.filterer.v1.FiltererService.ListFieldSets:input_type -> lopezator.filterer.v1.ListFieldSetsRequest
+	16, // 42: lopezator.filterer.v1.FiltererService.DescribeFieldSet:input_type -> lopezator.filterer.v1.DescribeFieldSetRequest
+	20, // 43: lopezator.filterer.v1.FiltererService.Complete:input_type -> lopezator.filterer.v1.CompleteRequest
+	23, // 44: lopezator.filterer.v1.FiltererService.Explain:input_type -> lopezator.filterer.v1.ExplainRequest
+	26, // 45: lopezator.filterer.v1.FiltererService.Evaluate:input_type -> lopezator.filterer.v1.EvaluateRequest
+	7,  // 46: lopezator.filterer.v1.FiltererService.Filter:output_type -> lopezator.filterer.v1.FilterResponse
+	11, // 47: lopezator.filterer.v1.FiltererService.Validate:output_type -> lopezator.filterer.v1.ValidateResponse
+	15, // 48: lopezator.filterer.v1.FiltererService.ListFieldSets:output_type -> lopezator.filterer.v1.ListFieldSetsResponse
+	17, // 49: lopezator.filterer.v1.FiltererService.DescribeFieldSet:output_type -> lopezator.filterer.v1.DescribeFieldSetResponse
+	21, // 50: lopezator.filterer.v1.FiltererService.Complete:output_type -> lopezator.filterer.v1.CompleteResponse
+	24, // 51: lopezator.filterer.v1.FiltererService.Explain:output_type -> lopezator.filterer.v1.ExplainResponse
+	27, // 52: lopezator.filterer.v1.FiltererService.Evaluate:output_type -> lopezator.filterer.v1.EvaluateResponse
+	46, // [46:53] is the sub-list for method output_type
+	39, // [39:46] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
+}
+
+func init() { file_lopezator_filterer_v1_filterer_proto_init() }
+func file_lopezator_filterer_v1_filterer_proto_init() {
+	if File_lopezator_filterer_v1_filterer_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Argument); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Diagnostic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Span); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFieldSetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFieldSetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeFieldSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeFieldSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Field); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Suggestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Expression); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lopezator_filterer_v1_filterer_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_lopezator_filterer_v1_filterer_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*Argument_StringValue)(nil),
+		(*Argument_Int64Value)(nil),
+		(*Argument_DoubleValue)(nil),
+		(*Argument_BoolValue)(nil),
+		(*Argument_BytesValue)(nil),
+		(*Argument_TimestampValue)(nil),
+		(*Argument_StringListValue)(nil),
+		(*Argument_NullValue)(nil),
+		(*Argument_Uint64Value)(nil),
+	}
+	file_lopezator_filterer_v1_filterer_proto_msgTypes[23].OneofWrappers = []interface{}{
+		(*Node_Not)(nil),
+		(*Node_And)(nil),
+		(*Node_Or)(nil),
+		(*Node_Op)(nil),
+		(*Node_Present)(nil),
+		(*Node_Size)(nil),
+		(*Node_Field)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_lopezator_filterer_v1_filterer_proto_rawDesc,
+			NumEnums:      6,
+			NumMessages:   27,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lopezator_filterer_v1_filterer_proto_goTypes,
+		DependencyIndexes: file_lopezator_filterer_v1_filterer_proto_depIdxs,
+		EnumInfos:         file_lopezator_filterer_v1_filterer_proto_enumTypes,
+		MessageInfos:      file_lopezator_filterer_v1_filterer_proto_msgTypes,
+	}.Build()
+	File_lopezator_filterer_v1_filterer_proto = out.File
+	file_lopezator_filterer_v1_filterer_proto_rawDesc = nil
+	file_lopezator_filterer_v1_filterer_proto_goTypes = nil
+	file_lopezator_filterer_v1_filterer_proto_depIdxs = nil
+}
@@ -0,0 +1,305 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lopezator/filterer/v1/filterer.proto
+
+package filtererv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/lopezator/filterer/proto/lopezator/filterer/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// FiltererServiceName is the fully-qualified name of the FiltererService service.
+	FiltererServiceName = "lopezator.filterer.v1.FiltererService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// FiltererServiceFilterProcedure is the fully-qualified name of the FiltererService's Filter RPC.
+	FiltererServiceFilterProcedure = "/lopezator.filterer.v1.FiltererService/Filter"
+	// FiltererServiceValidateProcedure is the fully-qualified name of the FiltererService's Validate
+	// RPC.
+	FiltererServiceValidateProcedure = "/lopezator.filterer.v1.FiltererService/Validate"
+	// FiltererServiceListFieldSetsProcedure is the fully-qualified name of the FiltererService's
+	// ListFieldSets RPC.
+	FiltererServiceListFieldSetsProcedure = "/lopezator.filterer.v1.FiltererService/ListFieldSets"
+	// FiltererServiceDescribeFieldSetProcedure is the fully-qualified name of the FiltererService's
+	// DescribeFieldSet RPC.
+	FiltererServiceDescribeFieldSetProcedure = "/lopezator.filterer.v1.FiltererService/DescribeFieldSet"
+	// FiltererServiceCompleteProcedure is the fully-qualified name of the FiltererService's Complete
+	// RPC.
+	FiltererServiceCompleteProcedure = "/lopezator.filterer.v1.FiltererService/Complete"
+	// FiltererServiceExplainProcedure is the fully-qualified name of the FiltererService's Explain RPC.
+	FiltererServiceExplainProcedure = "/lopezator.filterer.v1.FiltererService/Explain"
+	// FiltererServiceEvaluateProcedure is the fully-qualified name of the FiltererService's Evaluate
+	// RPC.
+	FiltererServiceEvaluateProcedure = "/lopezator.filterer.v1.FiltererService/Evaluate"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	filtererServiceServiceDescriptor                = v1.File_lopezator_filterer_v1_filterer_proto.Services().ByName("FiltererService")
+	filtererServiceFilterMethodDescriptor           = filtererServiceServiceDescriptor.Methods().ByName("Filter")
+	filtererServiceValidateMethodDescriptor         = filtererServiceServiceDescriptor.Methods().ByName("Validate")
+	filtererServiceListFieldSetsMethodDescriptor    = filtererServiceServiceDescriptor.Methods().ByName("ListFieldSets")
+	filtererServiceDescribeFieldSetMethodDescriptor = filtererServiceServiceDescriptor.Methods().ByName("DescribeFieldSet")
+	filtererServiceCompleteMethodDescriptor         = filtererServiceServiceDescriptor.Methods().ByName("Complete")
+	filtererServiceExplainMethodDescriptor          = filtererServiceServiceDescriptor.Methods().ByName("Explain")
+	filtererServiceEvaluateMethodDescriptor         = filtererServiceServiceDescriptor.Methods().ByName("Evaluate")
+)
+
+// FiltererServiceClient is a client for the lopezator.filterer.v1.FiltererService service.
+type FiltererServiceClient interface {
+	// Filter does the filterer magic!
+	Filter(context.Context, *connect.Request[v1.FilterRequest]) (*connect.Response[v1.FilterResponse], error)
+	// Validate checks a filter expression against a field set, without generating SQL.
+	Validate(context.Context, *connect.Request[v1.ValidateRequest]) (*connect.Response[v1.ValidateResponse], error)
+	// ListFieldSets lists the field sets expressions can be checked against.
+	ListFieldSets(context.Context, *connect.Request[v1.ListFieldSetsRequest]) (*connect.Response[v1.ListFieldSetsResponse], error)
+	// DescribeFieldSet describes the fields of a field set and their operators.
+	DescribeFieldSet(context.Context, *connect.Request[v1.DescribeFieldSetRequest]) (*connect.Response[v1.DescribeFieldSetResponse], error)
+	// Complete suggests how to complete a partial filter expression.
+	Complete(context.Context, *connect.Request[v1.CompleteRequest]) (*connect.Response[v1.CompleteResponse], error)
+	// Explain describes how a filter expression is parsed and translated to SQL.
+	Explain(context.Context, *connect.Request[v1.ExplainRequest]) (*connect.Response[v1.ExplainResponse], error)
+	// Evaluate matches a batch of JSON records against a filter expression, as their SQL clause would.
+	Evaluate(context.Context, *connect.Request[v1.EvaluateRequest]) (*connect.Response[v1.EvaluateResponse], error)
+}
+
+// NewFiltererServiceClient constructs a client for the lopezator.filterer.v1.FiltererService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewFiltererServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) FiltererServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &filtererServiceClient{
+		filter: connect.NewClient[v1.FilterRequest, v1.FilterResponse](
+			httpClient,
+			baseURL+FiltererServiceFilterProcedure,
+			connect.WithSchema(filtererServiceFilterMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		validate: connect.NewClient[v1.ValidateRequest, v1.ValidateResponse](
+			httpClient,
+			baseURL+FiltererServiceValidateProcedure,
+			connect.WithSchema(filtererServiceValidateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listFieldSets: connect.NewClient[v1.ListFieldSetsRequest, v1.ListFieldSetsResponse](
+			httpClient,
+			baseURL+FiltererServiceListFieldSetsProcedure,
+			connect.WithSchema(filtererServiceListFieldSetsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		describeFieldSet: connect.NewClient[v1.DescribeFieldSetRequest, v1.DescribeFieldSetResponse](
+			httpClient,
+			baseURL+FiltererServiceDescribeFieldSetProcedure,
+			connect.WithSchema(filtererServiceDescribeFieldSetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		complete: connect.NewClient[v1.CompleteRequest, v1.CompleteResponse](
+			httpClient,
+			baseURL+FiltererServiceCompleteProcedure,
+			connect.WithSchema(filtererServiceCompleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		explain: connect.NewClient[v1.ExplainRequest, v1.ExplainResponse](
+			httpClient,
+			baseURL+FiltererServiceExplainProcedure,
+			connect.WithSchema(filtererServiceExplainMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		evaluate: connect.NewClient[v1.EvaluateRequest, v1.EvaluateResponse](
+			httpClient,
+			baseURL+FiltererServiceEvaluateProcedure,
+			connect.WithSchema(filtererServiceEvaluateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// filtererServiceClient implements FiltererServiceClient.
+type filtererServiceClient struct {
+	filter           *connect.Client[v1.FilterRequest, v1.FilterResponse]
+	validate         *connect.Client[v1.ValidateRequest, v1.ValidateResponse]
+	listFieldSets    *connect.Client[v1.ListFieldSetsRequest, v1.ListFieldSetsResponse]
+	describeFieldSet *connect.Client[v1.DescribeFieldSetRequest, v1.DescribeFieldSetResponse]
+	complete         *connect.Client[v1.CompleteRequest, v1.CompleteResponse]
+	explain          *connect.Client[v1.ExplainRequest, v1.ExplainResponse]
+	evaluate         *connect.Client[v1.EvaluateRequest, v1.EvaluateResponse]
+}
+
+// Filter calls lopezator.filterer.v1.FiltererService.Filter.
+func (c *filtererServiceClient) Filter(ctx context.Context, req *connect.Request[v1.FilterRequest]) (*connect.Response[v1.FilterResponse], error) {
+	return c.filter.CallUnary(ctx, req)
+}
+
+// Validate calls lopezator.filterer.v1.FiltererService.Validate.
+func (c *filtererServiceClient) Validate(ctx context.Context, req *connect.Request[v1.ValidateRequest]) (*connect.Response[v1.ValidateResponse], error) {
+	return c.validate.CallUnary(ctx, req)
+}
+
+// ListFieldSets calls lopezator.filterer.v1.FiltererService.ListFieldSets.
+func (c *filtererServiceClient) ListFieldSets(ctx context.Context, req *connect.Request[v1.ListFieldSetsRequest]) (*connect.Response[v1.ListFieldSetsResponse], error) {
+	return c.listFieldSets.CallUnary(ctx, req)
+}
+
+// DescribeFieldSet calls lopezator.filterer.v1.FiltererService.DescribeFieldSet.
+func (c *filtererServiceClient) DescribeFieldSet(ctx context.Context, req *connect.Request[v1.DescribeFieldSetRequest]) (*connect.Response[v1.DescribeFieldSetResponse], error) {
+	return c.describeFieldSet.CallUnary(ctx, req)
+}
+
+// Complete calls lopezator.filterer.v1.FiltererService.Complete.
+func (c *filtererServiceClient) Complete(ctx context.Context, req *connect.Request[v1.CompleteRequest]) (*connect.Response[v1.CompleteResponse], error) {
+	return c.complete.CallUnary(ctx, req)
+}
+
+// Explain calls lopezator.filterer.v1.FiltererService.Explain.
+func (c *filtererServiceClient) Explain(ctx context.Context, req *connect.Request[v1.ExplainRequest]) (*connect.Response[v1.ExplainResponse], error) {
+	return c.explain.CallUnary(ctx, req)
+}
+
+// Evaluate calls lopezator.filterer.v1.FiltererService.Evaluate.
+func (c *filtererServiceClient) Evaluate(ctx context.Context, req *connect.Request[v1.EvaluateRequest]) (*connect.Response[v1.EvaluateResponse], error) {
+	return c.evaluate.CallUnary(ctx, req)
+}
+
+// FiltererServiceHandler is an implementation of the lopezator.filterer.v1.FiltererService service.
+type FiltererServiceHandler interface {
+	// Filter does the filterer magic!
+	Filter(context.Context, *connect.Request[v1.FilterRequest]) (*connect.Response[v1.FilterResponse], error)
+	// Validate checks a filter expression against a field set, without generating SQL.
+	Validate(context.Context, *connect.Request[v1.ValidateRequest]) (*connect.Response[v1.ValidateResponse], error)
+	// ListFieldSets lists the field sets expressions can be checked against.
+	ListFieldSets(context.Context, *connect.Request[v1.ListFieldSetsRequest]) (*connect.Response[v1.ListFieldSetsResponse], error)
+	// DescribeFieldSet describes the fields of a field set and their operators.
+	DescribeFieldSet(context.Context, *connect.Request[v1.DescribeFieldSetRequest]) (*connect.Response[v1.DescribeFieldSetResponse], error)
+	// Complete suggests how to complete a partial filter expression.
+	Complete(context.Context, *connect.Request[v1.CompleteRequest]) (*connect.Response[v1.CompleteResponse], error)
+	// Explain describes how a filter expression is parsed and translated to SQL.
+	Explain(context.Context, *connect.Request[v1.ExplainRequest]) (*connect.Response[v1.ExplainResponse], error)
+	// Evaluate matches a batch of JSON records against a filter expression, as their SQL clause would.
+	Evaluate(context.Context, *connect.Request[v1.EvaluateRequest]) (*connect.Response[v1.EvaluateResponse], error)
+}
+
+// NewFiltererServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewFiltererServiceHandler(svc FiltererServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	filtererServiceFilterHandler := connect.NewUnaryHandler(
+		FiltererServiceFilterProcedure,
+		svc.Filter,
+		connect.WithSchema(filtererServiceFilterMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	filtererServiceValidateHandler := connect.NewUnaryHandler(
+		FiltererServiceValidateProcedure,
+		svc.Validate,
+		connect.WithSchema(filtererServiceValidateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	filtererServiceListFieldSetsHandler := connect.NewUnaryHandler(
+		FiltererServiceListFieldSetsProcedure,
+		svc.ListFieldSets,
+		connect.WithSchema(filtererServiceListFieldSetsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	filtererServiceDescribeFieldSetHandler := connect.NewUnaryHandler(
+		FiltererServiceDescribeFieldSetProcedure,
+		svc.DescribeFieldSet,
+		connect.WithSchema(filtererServiceDescribeFieldSetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	filtererServiceCompleteHandler := connect.NewUnaryHandler(
+		FiltererServiceCompleteProcedure,
+		svc.Complete,
+		connect.WithSchema(filtererServiceCompleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	filtererServiceExplainHandler := connect.NewUnaryHandler(
+		FiltererServiceExplainProcedure,
+		svc.Explain,
+		connect.WithSchema(filtererServiceExplainMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	filtererServiceEvaluateHandler := connect.NewUnaryHandler(
+		FiltererServiceEvaluateProcedure,
+		svc.Evaluate,
+		connect.WithSchema(filtererServiceEvaluateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lopezator.filterer.v1.FiltererService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case FiltererServiceFilterProcedure:
+			filtererServiceFilterHandler.ServeHTTP(w, r)
+		case FiltererServiceValidateProcedure:
+			filtererServiceValidateHandler.ServeHTTP(w, r)
+		case FiltererServiceListFieldSetsProcedure:
+			filtererServiceListFieldSetsHandler.ServeHTTP(w, r)
+		case FiltererServiceDescribeFieldSetProcedure:
+			filtererServiceDescribeFieldSetHandler.ServeHTTP(w, r)
+		case FiltererServiceCompleteProcedure:
+			filtererServiceCompleteHandler.ServeHTTP(w, r)
+		case FiltererServiceExplainProcedure:
+			filtererServiceExplainHandler.ServeHTTP(w, r)
+		case FiltererServiceEvaluateProcedure:
+			filtererServiceEvaluateHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedFiltererServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedFiltererServiceHandler struct{}
+
+func (UnimplementedFiltererServiceHandler) Filter(context.Context, *connect.Request[v1.FilterRequest]) (*connect.Response[v1.FilterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lopezator.filterer.v1.FiltererService.Filter is not implemented"))
+}
+
+func (UnimplementedFiltererServiceHandler) Validate(context.Context, *connect.Request[v1.ValidateRequest]) (*connect.Response[v1.ValidateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lopezator.filterer.v1.FiltererService.Validate is not implemented"))
+}
+
+func (UnimplementedFiltererServiceHandler) ListFieldSets(context.Context, *connect.Request[v1.ListFieldSetsRequest]) (*connect.Response[v1.ListFieldSetsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lopezator.filterer.v1.FiltererService.ListFieldSets is not implemented"))
+}
+
+func (UnimplementedFiltererServiceHandler) DescribeFieldSet(context.Context, *connect.Request[v1.DescribeFieldSetRequest]) (*connect.Response[v1.DescribeFieldSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lopezator.filterer.v1.FiltererService.DescribeFieldSet is not implemented"))
+}
+
+func (UnimplementedFiltererServiceHandler) Complete(context.Context, *connect.Request[v1.CompleteRequest]) (*connect.Response[v1.CompleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lopezator.filterer.v1.FiltererService.Complete is not implemented"))
+}
+
+func (UnimplementedFiltererServiceHandler) Explain(context.Context, *connect.Request[v1.ExplainRequest]) (*connect.Response[v1.ExplainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lopezator.filterer.v1.FiltererService.Explain is not implemented"))
+}
+
+func (UnimplementedFiltererServiceHandler) Evaluate(context.Context, *connect.Request[v1.EvaluateRequest]) (*connect.Response[v1.EvaluateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lopezator.filterer.v1.FiltererService.Evaluate is not implemented"))
+}